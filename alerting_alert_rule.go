@@ -3,7 +3,10 @@ package gapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -30,15 +33,18 @@ type AlertRule struct {
 
 // RuleGroup represents a group of rules in Grafana Alerting.
 type RuleGroup struct {
-	Title     string      `json:"title"`
-	FolderUID string      `json:"folderUid"`
-	Interval  int64       `json:"interval"`
-	Rules     []AlertRule `json:"rules"`
+	Title     string `json:"title"`
+	FolderUID string `json:"folderUid"`
+	// Interval is the evaluation interval of all rules in the group, in seconds.
+	Interval int64       `json:"interval"`
+	Rules    []AlertRule `json:"rules"`
 }
 
 // AlertQuery represents a single query stage associated with an alert definition.
 type AlertQuery struct {
-	DatasourceUID     string            `json:"datasourceUid,omitempty"`
+	DatasourceUID string `json:"datasourceUid,omitempty"`
+	// Model is the data source specific part of the query, such as the expression and its refId.
+	// It is decoded as a map[string]interface{}, and can be set to any value marshaling to a JSON object.
 	Model             interface{}       `json:"model"`
 	QueryType         string            `json:"queryType,omitempty"`
 	RefID             string            `json:"refId,omitempty"`
@@ -57,12 +63,23 @@ const (
 	NoDataAlerting NoDataState  = "Alerting"
 )
 
-// RelativeTimeRange represents the time range for an alert query.
+// RelativeTimeRange represents the time range for an alert query, relative to the evaluation time.
+// From and To are numbers of seconds, as sent to and returned by the API, despite their time.Duration type:
+// From 600 and To 0 queries the last 10 minutes. Use NewRelativeTimeRange to set them from durations.
 type RelativeTimeRange struct {
 	From time.Duration `json:"from"`
 	To   time.Duration `json:"to"`
 }
 
+// NewRelativeTimeRange returns the RelativeTimeRange querying from the evaluation time minus from to
+// the evaluation time minus to, truncated to the second.
+func NewRelativeTimeRange(from, to time.Duration) RelativeTimeRange {
+	return RelativeTimeRange{
+		From: from / time.Second,
+		To:   to / time.Second,
+	}
+}
+
 // AlertRule fetches a single alert rule, identified by its UID.
 func (c *Client) AlertRule(uid string) (AlertRule, error) {
 	path := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", uid)
@@ -76,14 +93,15 @@ func (c *Client) AlertRule(uid string) (AlertRule, error) {
 
 // AlertRuleGroup fetches a group of alert rules, identified by its name and the UID of its folder.
 func (c *Client) AlertRuleGroup(folderUID string, name string) (RuleGroup, error) {
-	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, name)
+	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, url.PathEscape(name))
 	result := RuleGroup{}
 	err := c.request("GET", path, nil, nil, &result)
 	return result, err
 }
 
 // SetAlertRuleGroup overwrites an existing rule group on the server.
-func (c *Client) SetAlertRuleGroup(group RuleGroup) error {
+// Optional query parameters are sent along with the request.
+func (c *Client) SetAlertRuleGroup(group RuleGroup, optionalQueryParams ...url.Values) error {
 	syncCalculatedRuleGroupFields(&group)
 	folderUID := group.FolderUID
 	name := group.Title
@@ -92,19 +110,33 @@ func (c *Client) SetAlertRuleGroup(group RuleGroup) error {
 		return err
 	}
 
-	uri := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, name)
-	return c.request("PUT", uri, nil, bytes.NewBuffer(req), nil)
+	uri := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, url.PathEscape(name))
+	return c.request("PUT", uri, mergeQueryParams(optionalQueryParams), bytes.NewBuffer(req), nil)
+}
+
+// SetAlertRuleGroupInterval changes the evaluation interval of a group of alert rules,
+// identified by its name and the UID of its folder, keeping its rules.
+// Use WithoutProvenance to keep the group editable in the Grafana UI.
+func (c *Client) SetAlertRuleGroupInterval(folderUID string, name string, interval time.Duration) error {
+	group, err := c.AlertRuleGroup(folderUID, name)
+	if err != nil {
+		return err
+	}
+
+	group.Interval = int64(interval / time.Second)
+	return c.SetAlertRuleGroup(group)
 }
 
 // NewAlertRule creates a new alert rule and returns its UID.
-func (c *Client) NewAlertRule(ar *AlertRule) (string, error) {
+// Optional query parameters are sent along with the request.
+func (c *Client) NewAlertRule(ar *AlertRule, optionalQueryParams ...url.Values) (string, error) {
 	syncCalculatedRuleFields(ar)
 	req, err := json.Marshal(ar)
 	if err != nil {
 		return "", err
 	}
 	result := AlertRule{}
-	err = c.request("POST", "/api/v1/provisioning/alert-rules", nil, bytes.NewBuffer(req), &result)
+	err = c.request("POST", "/api/v1/provisioning/alert-rules", mergeQueryParams(optionalQueryParams), bytes.NewBuffer(req), &result)
 	if err != nil {
 		return "", err
 	}
@@ -112,7 +144,8 @@ func (c *Client) NewAlertRule(ar *AlertRule) (string, error) {
 }
 
 // UpdateAlertRule replaces an alert rule, identified by the alert rule's UID.
-func (c *Client) UpdateAlertRule(ar *AlertRule) error {
+// Optional query parameters are sent along with the request.
+func (c *Client) UpdateAlertRule(ar *AlertRule, optionalQueryParams ...url.Values) error {
 	syncCalculatedRuleFields(ar)
 	uri := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", ar.UID)
 	req, err := json.Marshal(ar)
@@ -120,7 +153,30 @@ func (c *Client) UpdateAlertRule(ar *AlertRule) error {
 		return err
 	}
 
-	return c.request("PUT", uri, nil, bytes.NewBuffer(req), nil)
+	return c.request("PUT", uri, mergeQueryParams(optionalQueryParams), bytes.NewBuffer(req), nil)
+}
+
+// SetAlertRulePaused pauses or resumes the evaluation of the alert rule whose UID it's passed. The rule is
+// written back as it was read, so that fields this client doesn't model are kept, and remains editable in
+// the Grafana UI.
+func (c *Client) SetAlertRulePaused(uid string, paused bool) error {
+	uri := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", uid)
+	rule := make(map[string]interface{})
+	if err := c.request("GET", uri, nil, nil, &rule); err != nil {
+		var apiErr APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("alert rule %s not found: %w", uid, err)
+		}
+		return err
+	}
+
+	rule["isPaused"] = paused
+	req, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+
+	return c.WithoutProvenance().request("PUT", uri, nil, bytes.NewBuffer(req), nil)
 }
 
 // DeleteAlertRule deletes a alert rule, identified by the alert rule's UID.
@@ -0,0 +1,79 @@
+package gapi
+
+import "fmt"
+
+// AlertRuleStatus represents the evaluation state of a Grafana-managed alert rule, as reported by
+// the Prometheus-compatible rules API.
+type AlertRuleStatus struct {
+	UID  string `json:"uid"`
+	Name string `json:"name"`
+	// Folder and Group are the title of the rule's folder and the name of its rule group.
+	Folder string `json:"-"`
+	Group  string `json:"-"`
+	// State is firing, pending or inactive. Health is ok, error or nodata.
+	State          string            `json:"state"`
+	Health         string            `json:"health"`
+	LastError      string            `json:"lastError"`
+	Labels         map[string]string `json:"labels"`
+	LastEvaluation GrafanaTime       `json:"lastEvaluation"`
+	// ActiveAt is when the rule's oldest alert instance became active. It's zero when no instance is.
+	ActiveAt GrafanaTime     `json:"activeAt"`
+	Alerts   []AlertInstance `json:"alerts"`
+}
+
+// AlertInstance represents an alert instance, one per label set, of a Grafana-managed alert rule.
+type AlertInstance struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	// State is Normal, Pending, Alerting, NoData or Error.
+	State    string      `json:"state"`
+	ActiveAt GrafanaTime `json:"activeAt"`
+	Value    string      `json:"value"`
+}
+
+// AlertRuleStatuses fetches and returns the current state of all Grafana-managed alert rules.
+func (c *Client) AlertRuleStatuses() ([]AlertRuleStatus, error) {
+	var resp struct {
+		Data struct {
+			Groups []struct {
+				Name  string            `json:"name"`
+				File  string            `json:"file"`
+				Rules []AlertRuleStatus `json:"rules"`
+			} `json:"groups"`
+		} `json:"data"`
+	}
+	if err := c.request("GET", "/api/prometheus/grafana/api/v1/rules", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]AlertRuleStatus, 0)
+	for _, group := range resp.Data.Groups {
+		for _, rule := range group.Rules {
+			rule.Folder = group.File
+			rule.Group = group.Name
+			statuses = append(statuses, rule)
+		}
+	}
+
+	return statuses, nil
+}
+
+// AlertInstances fetches and returns the active alert instances of the Grafana-managed alert rule whose UID
+// it's passed.
+func (c *Client) AlertInstances(ruleUID string) ([]AlertInstance, error) {
+	// The instances are taken from the rule's status, as the alerts endpoint strips the internal labels
+	// identifying the rule of each instance.
+	statuses, err := c.AlertRuleStatuses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch alert instances of rule %s: %w", ruleUID, err)
+	}
+
+	for _, status := range statuses {
+		if status.UID == ruleUID {
+			instances := make([]AlertInstance, 0, len(status.Alerts))
+			return append(instances, status.Alerts...), nil
+		}
+	}
+
+	return nil, fmt.Errorf("alert rule with uid %s not found", ruleUID)
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gobs/pretty"
+)
+
+const getAlertRuleStatusesJSON = `{
+	"status": "success",
+	"data": {
+		"groups": [
+			{
+				"name": "eval_group_1",
+				"file": "Project Test",
+				"rules": [
+					{
+						"uid": "123abcd",
+						"name": "Always in alarm",
+						"state": "firing",
+						"health": "ok",
+						"lastError": "",
+						"type": "alerting",
+						"labels": {"team": "ops"},
+						"lastEvaluation": "2023-06-01T12:00:10Z",
+						"evaluationTime": 0.01,
+						"activeAt": "2023-06-01T11:00:00Z",
+						"alerts": [
+							{
+								"labels": {"alertname": "Always in alarm"},
+								"annotations": {},
+								"state": "Alerting",
+								"activeAt": "2023-06-01T11:00:00Z",
+								"value": "[ var='A' labels={} value=1 ]"
+							}
+						]
+					}
+				]
+			}
+		]
+	}
+}`
+
+func TestAlertRuleStatuses(t *testing.T) {
+	client := gapiTestTools(t, 200, getAlertRuleStatusesJSON)
+
+	statuses, err := client.AlertRuleStatuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(statuses))
+
+	if len(statuses) != 1 {
+		t.Fatalf("Expected 1 rule status, got %d", len(statuses))
+	}
+	status := statuses[0]
+	if status.UID != "123abcd" || status.State != "firing" || status.Health != "ok" || status.Folder != "Project Test" || status.Group != "eval_group_1" {
+		t.Errorf("Not correctly parsing returned rule status - %v", status)
+	}
+	if !status.ActiveAt.Time().Equal(time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC)) || len(status.Alerts) != 1 {
+		t.Errorf("Not correctly parsing returned rule status - %v", status)
+	}
+}
+
+func TestAlertInstances(t *testing.T) {
+	client := gapiTestTools(t, 200, getAlertRuleStatusesJSON)
+
+	instances, err := client.AlertInstances("123abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(instances))
+
+	if len(instances) != 1 || instances[0].State != "Alerting" || instances[0].Labels["alertname"] != "Always in alarm" {
+		t.Errorf("Expected the rule's alerting instance, got %v", instances)
+	}
+
+	client = gapiTestTools(t, 200, getAlertRuleStatusesJSON)
+	if _, err := client.AlertInstances("other"); err == nil {
+		t.Error("Expected not found error")
+	}
+}
@@ -2,6 +2,11 @@ package gapi
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -89,6 +94,36 @@ func TestAlertRules(t *testing.T) {
 		}
 	})
 
+	t.Run("set alert rule group interval succeeds", func(t *testing.T) {
+		var group RuleGroup
+		client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				if r.Header.Get("X-Disable-Provenance") != "true" {
+					t.Error("expected X-Disable-Provenance header")
+				}
+				if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+					t.Error(err)
+				}
+			}
+			fmt.Fprint(w, getAlertRuleGroupJSON)
+		}))
+
+		err := client.WithoutProvenance().SetAlertRuleGroupInterval("project_test", "eval_group_1", 2*time.Minute)
+
+		if err != nil {
+			t.Error(err)
+		}
+		if group.Interval != 120 {
+			t.Errorf("incorrect interval - expected %d got %d", 120, group.Interval)
+		}
+		if len(group.Rules) != 1 {
+			t.Errorf("wrong number of rules, got %d", len(group.Rules))
+		}
+		if client.config.HTTPHeaders != nil {
+			t.Error("expected the original client headers to be left unchanged")
+		}
+	})
+
 	t.Run("update alert rule succeeds", func(t *testing.T) {
 		client := gapiTestTools(t, 200, writeAlertRuleJSON)
 		alertRule := createAlertRule()
@@ -254,3 +289,88 @@ const getAlertRuleGroupJSON = `
 	  }
 	]
   }`
+
+func TestAlertQueryRoundTrip(t *testing.T) {
+	// This query is copied from a rule exported from Grafana.
+	const queryJSON = `{"datasourceUid":"PBFA97CFB590B2093","model":{"editorMode":"code","expr":"up == 0","instant":true,"intervalMs":1000,"maxDataPoints":43200,"refId":"A"},"queryType":"","refId":"A","relativeTimeRange":{"from":600,"to":0}}`
+
+	var query AlertQuery
+	if err := json.Unmarshal([]byte(queryJSON), &query); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(query))
+
+	if query.RefID != "A" || query.DatasourceUID != "PBFA97CFB590B2093" {
+		t.Errorf("incorrect query - got refId %s, datasourceUid %s", query.RefID, query.DatasourceUID)
+	}
+	if query.RelativeTimeRange != NewRelativeTimeRange(10*time.Minute, 0) {
+		t.Errorf("incorrect relative time range - got %v", query.RelativeTimeRange)
+	}
+	model, ok := query.Model.(map[string]interface{})
+	if !ok || model["expr"] != "up == 0" {
+		t.Errorf("incorrect model - got %v", query.Model)
+	}
+
+	data, err := json.Marshal(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTripped AlertQuery
+	if err := json.Unmarshal(data, &roundTripped); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(query, roundTripped) || !strings.Contains(string(data), `"relativeTimeRange":{"from":600,"to":0}`) {
+		t.Errorf("query did not round trip - expected %s got %s", queryJSON, data)
+	}
+}
+
+func TestSetAlertRulePaused(t *testing.T) {
+	var (
+		updated    map[string]interface{}
+		provenance string
+	)
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"uid": "123123", "title": "Always in alarm", "isPaused": false, "notification_settings": {"receiver": "ops"}}`)
+			return
+		}
+		provenance = r.Header.Get("X-Disable-Provenance")
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+
+	if err := client.SetAlertRulePaused("123123", true); err != nil {
+		t.Fatal(err)
+	}
+	if updated["isPaused"] != true || updated["title"] != "Always in alarm" || updated["notification_settings"] == nil {
+		t.Errorf("Expected the rule to be paused and its other fields kept, got %v", updated)
+	}
+	if provenance != "true" {
+		t.Errorf("Expected provenance to be disabled, got %q", provenance)
+	}
+
+	client = gapiTestTools(t, 404, `{"message": "not found"}`)
+	err := client.SetAlertRulePaused("missing", true)
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || !strings.Contains(err.Error(), "alert rule missing not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestRelativeTimeRange_seconds(t *testing.T) {
+	// Callers set the number of seconds sent to the API.
+	data, err := json.Marshal(RelativeTimeRange{From: 600, To: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"from":600,"to":0}` {
+		t.Errorf("expected the raw number of seconds to be sent, got %s", data)
+	}
+
+	if r := NewRelativeTimeRange(10*time.Minute, time.Minute); r.From != 600 || r.To != 60 {
+		t.Errorf("incorrect relative time range - got %v", r)
+	}
+}
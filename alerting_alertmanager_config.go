@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const alertmanagerConfigURL = "/api/alertmanager/grafana/config/api/v1/alerts"
+
+// AlertmanagerUserConfig is the whole configuration of the Grafana Alertmanager, managed as a single blob
+// rather than through the provisioning APIs, e.g. when migrating an existing Alertmanager configuration.
+type AlertmanagerUserConfig struct {
+	// TemplateFiles holds the notification templates, by name.
+	TemplateFiles      map[string]string  `json:"template_files"`
+	AlertmanagerConfig AlertmanagerConfig `json:"alertmanager_config"`
+}
+
+// AlertmanagerConfig is the Alertmanager part of an AlertmanagerUserConfig. Its sections are kept as decoded JSON,
+// in the format of the Alertmanager configuration, so that configurations round-trip without losing fields.
+type AlertmanagerConfig struct {
+	Global map[string]interface{} `json:"global,omitempty"`
+	// Route is the root of the notification routing tree.
+	Route             map[string]interface{}   `json:"route"`
+	InhibitRules      []map[string]interface{} `json:"inhibit_rules,omitempty"`
+	MuteTimeIntervals []map[string]interface{} `json:"mute_time_intervals,omitempty"`
+	Templates         []string                 `json:"templates,omitempty"`
+	// Receivers are the contact points, each holding a name and its grafana_managed_receiver_configs.
+	Receivers []map[string]interface{} `json:"receivers"`
+}
+
+// AlertmanagerConfig fetches and returns the configuration of the Grafana Alertmanager.
+func (c *Client) AlertmanagerConfig() (*AlertmanagerUserConfig, error) {
+	cfg := &AlertmanagerUserConfig{}
+	err := c.request("GET", alertmanagerConfigURL, nil, nil, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// SetAlertmanagerConfig replaces the configuration of the Grafana Alertmanager.
+func (c *Client) SetAlertmanagerConfig(cfg AlertmanagerUserConfig) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	return c.request("POST", alertmanagerConfigURL, nil, bytes.NewBuffer(data), nil)
+}
+
+// ResetAlertmanagerConfig resets the configuration of the Grafana Alertmanager to its default.
+func (c *Client) ResetAlertmanagerConfig() error {
+	return c.request("DELETE", alertmanagerConfigURL, nil, nil, nil)
+}
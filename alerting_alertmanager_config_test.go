@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const getAlertmanagerConfigJSON = `{
+	"template_files": {"slack": "{{ define \"slack.title\" }}{{ .CommonLabels.alertname }}{{ end }}"},
+	"alertmanager_config": {
+		"route": {
+			"receiver": "ops",
+			"group_by": ["alertname"],
+			"routes": [{"receiver": "payments", "matchers": ["team=\"payments\""]}]
+		},
+		"templates": ["slack"],
+		"receivers": [
+			{"name": "ops", "grafana_managed_receiver_configs": [{"uid": "abc", "name": "ops", "type": "email", "settings": {"addresses": "ops@example.com"}}]},
+			{"name": "payments", "grafana_managed_receiver_configs": [{"uid": "def", "name": "payments", "type": "slack", "settings": {"recipient": "#payments"}}]}
+		]
+	}
+}`
+
+func TestAlertmanagerConfig(t *testing.T) {
+	client := gapiTestTools(t, 200, getAlertmanagerConfigJSON)
+
+	cfg, err := client.AlertmanagerConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(cfg))
+
+	if cfg.AlertmanagerConfig.Route["receiver"] != "ops" || len(cfg.AlertmanagerConfig.Receivers) != 2 || len(cfg.TemplateFiles) != 1 {
+		t.Errorf("Not correctly parsing returned config - %v", cfg)
+	}
+}
+
+func TestSetAlertmanagerConfig(t *testing.T) {
+	var body []byte
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/alertmanager/grafana/config/api/v1/alerts" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Method == "POST" {
+			var err error
+			if body, err = io.ReadAll(r.Body); err != nil {
+				t.Error(err)
+			}
+		}
+		fmt.Fprint(w, `{"message": "configuration created"}`)
+	}))
+
+	var cfg AlertmanagerUserConfig
+	if err := json.Unmarshal([]byte(getAlertmanagerConfigJSON), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetAlertmanagerConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	var sent, expected map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(getAlertmanagerConfigJSON), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(sent) != fmt.Sprint(expected) {
+		t.Errorf("expected the config to round trip; got %s", body)
+	}
+
+	if err := client.ResetAlertmanagerConfig(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,6 +3,7 @@ package gapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 )
@@ -85,3 +86,78 @@ func (c *Client) DeleteContactPoint(uid string) error {
 	uri := fmt.Sprintf("/api/v1/provisioning/contact-points/%s", uid)
 	return c.request("DELETE", uri, nil, nil, nil)
 }
+
+// ContactPointTestError is returned when a test notification couldn't be delivered through a contact point.
+type ContactPointTestError struct {
+	ContactPoint string
+	// Message is the delivery error reported by Grafana.
+	Message string
+	// Err is the underlying API error, if Grafana rejected the test request itself.
+	Err error
+}
+
+func (e *ContactPointTestError) Error() string {
+	return fmt.Sprintf("test notification through contact point %s failed: %s", e.ContactPoint, e.Message)
+}
+
+func (e *ContactPointTestError) Unwrap() error {
+	return e.Err
+}
+
+type testReceiverConfig struct {
+	UID                   string                 `json:"uid,omitempty"`
+	Name                  string                 `json:"name"`
+	Type                  string                 `json:"type,omitempty"`
+	Settings              map[string]interface{} `json:"settings,omitempty"`
+	DisableResolveMessage bool                   `json:"disableResolveMessage,omitempty"`
+	Status                string                 `json:"status,omitempty"`
+	Error                 string                 `json:"error,omitempty"`
+}
+
+type testReceiver struct {
+	Name    string               `json:"name"`
+	Configs []testReceiverConfig `json:"grafana_managed_receiver_configs"`
+}
+
+type testReceivers struct {
+	Receivers []testReceiver `json:"receivers"`
+}
+
+// TestContactPoint sends a test notification through the contact point it's passed, which doesn't need to be saved.
+// If the notification couldn't be delivered, a *ContactPointTestError carrying Grafana's message is returned.
+func (c *Client) TestContactPoint(p ContactPoint) error {
+	req, err := json.Marshal(testReceivers{Receivers: []testReceiver{{
+		Name: p.Name,
+		Configs: []testReceiverConfig{{
+			UID:                   p.UID,
+			Name:                  p.Name,
+			Type:                  p.Type,
+			Settings:              p.Settings,
+			DisableResolveMessage: p.DisableResolveMessage,
+		}},
+	}}})
+	if err != nil {
+		return err
+	}
+
+	result := testReceivers{}
+	err = c.request("POST", "/api/alertmanager/grafana/config/api/v1/receivers/test", nil, bytes.NewBuffer(req), &result)
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+		return &ContactPointTestError{ContactPoint: p.Name, Message: apiErr.Message(), Err: err}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Failed deliveries are reported per receiver, with a 207 Multi-Status response.
+	for _, receiver := range result.Receivers {
+		for _, config := range receiver.Configs {
+			if config.Status == "failed" {
+				return &ContactPointTestError{ContactPoint: p.Name, Message: config.Error}
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,11 +1,48 @@
 package gapi
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/gobs/pretty"
 )
 
+func TestTestContactPoint(t *testing.T) {
+	p := createContactPoint()
+
+	t.Run("delivered test notification succeeds", func(t *testing.T) {
+		client := gapiTestTools(t, 200, `{"receivers":[{"name":"slack-receiver-123","grafana_managed_receiver_configs":[{"name":"slack-receiver-123","uid":"","status":"ok"}]}],"notified_at":"2023-06-12T15:04:05Z"}`)
+
+		if err := client.TestContactPoint(p); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("failed delivery returns the delivery error", func(t *testing.T) {
+		client := gapiTestTools(t, 207, `{"receivers":[{"name":"slack-receiver-123","grafana_managed_receiver_configs":[{"name":"slack-receiver-123","uid":"","status":"failed","error":"channel_not_found"}]}],"notified_at":"2023-06-12T15:04:05Z"}`)
+
+		err := client.TestContactPoint(p)
+		var testErr *ContactPointTestError
+		if !errors.As(err, &testErr) || testErr.Message != "channel_not_found" {
+			t.Errorf("expected delivery error, got %v", err)
+		}
+	})
+
+	t.Run("rejected test request returns the server's message", func(t *testing.T) {
+		client := gapiTestTools(t, 400, `{"message":"failed to validate receiver: token must be specified"}`)
+
+		err := client.TestContactPoint(p)
+		var testErr *ContactPointTestError
+		if !errors.As(err, &testErr) || testErr.Message != "failed to validate receiver: token must be specified" {
+			t.Errorf("expected validation error, got %v", err)
+		}
+		var apiErr APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+			t.Errorf("expected wrapped API error, got %v", err)
+		}
+	})
+}
+
 func TestContactPoints(t *testing.T) {
 	t.Run("get contact points succeeds", func(t *testing.T) {
 		client := gapiTestTools(t, 200, getContactPointsJSON)
@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Export formats supported by the alerting provisioning export endpoints.
+const (
+	ExportFormatYAML = "yaml"
+	ExportFormatJSON = "json"
+	ExportFormatHCL  = "hcl"
+)
+
+// ExportOptions configures an export of Grafana Alerting resources.
+type ExportOptions struct {
+	// Format is one of yaml, json or hcl. Grafana defaults to yaml when empty.
+	Format string
+	// Download makes Grafana respond with a Content-Disposition attachment header.
+	Download bool
+}
+
+func (o ExportOptions) query() (url.Values, error) {
+	query := url.Values{}
+	switch o.Format {
+	case "":
+	case ExportFormatYAML, ExportFormatJSON, ExportFormatHCL:
+		query.Set("format", o.Format)
+	default:
+		return nil, fmt.Errorf("invalid export format %q, expected one of %s, %s or %s", o.Format, ExportFormatYAML, ExportFormatJSON, ExportFormatHCL)
+	}
+	if o.Download {
+		query.Set("download", strconv.FormatBool(o.Download))
+	}
+	return query, nil
+}
+
+// ExportAlertRules exports all alert rules in the given format.
+func (c *Client) ExportAlertRules(opts ExportOptions) (string, error) {
+	return c.exportAlerting("/api/v1/provisioning/alert-rules/export", opts)
+}
+
+// ExportContactPoints exports all contact points in the given format.
+func (c *Client) ExportContactPoints(opts ExportOptions) (string, error) {
+	return c.exportAlerting("/api/v1/provisioning/contact-points/export", opts)
+}
+
+// ExportNotificationPolicies exports the notification policy tree in the given format.
+func (c *Client) ExportNotificationPolicies(opts ExportOptions) (string, error) {
+	return c.exportAlerting("/api/v1/provisioning/policies/export", opts)
+}
+
+// ExportMuteTimings exports all mute timings in the given format.
+func (c *Client) ExportMuteTimings(opts ExportOptions) (string, error) {
+	return c.exportAlerting("/api/v1/provisioning/mute-timings/export", opts)
+}
+
+func (c *Client) exportAlerting(path string, opts ExportOptions) (string, error) {
+	query, err := opts.query()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := c.requestRaw("GET", path, query, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"testing"
+)
+
+const exportAlertRulesYAML = `apiVersion: 1
+groups:
+    - orgId: 1
+      name: eval
+      folder: Alerts
+      interval: 1m
+`
+
+func TestExportAlerting(t *testing.T) {
+	t.Run("export alert rules succeeds", func(t *testing.T) {
+		client := gapiTestTools(t, 200, exportAlertRulesYAML)
+
+		export, err := client.ExportAlertRules(ExportOptions{Format: ExportFormatYAML})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if export != exportAlertRulesYAML {
+			t.Errorf("unexpected export - expected %s, got %s", exportAlertRulesYAML, export)
+		}
+	})
+
+	t.Run("export with default format succeeds", func(t *testing.T) {
+		client := gapiTestToolsFromCalls(t, []mockServerCall{
+			{200, exportAlertRulesYAML},
+			{200, exportAlertRulesYAML},
+			{200, exportAlertRulesYAML},
+		})
+
+		for _, export := range []func(ExportOptions) (string, error){
+			client.ExportContactPoints,
+			client.ExportNotificationPolicies,
+			client.ExportMuteTimings,
+		} {
+			if _, err := export(ExportOptions{Download: true}); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	t.Run("invalid format fails", func(t *testing.T) {
+		client := gapiTestTools(t, 200, exportAlertRulesYAML)
+
+		_, err := client.ExportAlertRules(ExportOptions{Format: "xml"})
+		if err == nil {
+			t.Error("expected error for invalid format")
+		}
+	})
+
+	t.Run("export failure is detected", func(t *testing.T) {
+		client := gapiTestTools(t, 403, `{"message":"forbidden"}`)
+
+		_, err := client.ExportAlertRules(ExportOptions{})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
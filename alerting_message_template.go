@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // AlertingMessageTemplate is a re-usable template for Grafana Alerting messages.
@@ -25,7 +26,7 @@ func (c *Client) MessageTemplates() ([]AlertingMessageTemplate, error) {
 // MessageTemplate fetches a single message template, identified by its name.
 func (c *Client) MessageTemplate(name string) (*AlertingMessageTemplate, error) {
 	t := AlertingMessageTemplate{}
-	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", name)
+	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", url.PathEscape(name))
 	err := c.request("GET", uri, nil, nil, &t)
 	if err != nil {
 		return nil, err
@@ -43,12 +44,12 @@ func (c *Client) SetMessageTemplate(name, content string) error {
 		return err
 	}
 
-	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", name)
+	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", url.PathEscape(name))
 	return c.request("PUT", uri, nil, bytes.NewBuffer(body), nil)
 }
 
 // DeleteMessageTemplate deletes a message template.
 func (c *Client) DeleteMessageTemplate(name string) error {
-	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", name)
+	uri := fmt.Sprintf("/api/v1/provisioning/templates/%s", url.PathEscape(name))
 	return c.request("DELETE", uri, nil, nil, nil)
 }
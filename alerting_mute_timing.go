@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // MuteTiming represents a Grafana Alerting mute timing.
@@ -53,7 +54,7 @@ func (c *Client) MuteTimings() ([]MuteTiming, error) {
 // MuteTiming fetches a single mute timing, identified by its name.
 func (c *Client) MuteTiming(name string) (MuteTiming, error) {
 	mt := MuteTiming{}
-	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", name)
+	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", url.PathEscape(name))
 	err := c.request("GET", uri, nil, nil, &mt)
 	return mt, err
 }
@@ -70,7 +71,7 @@ func (c *Client) NewMuteTiming(mt *MuteTiming) error {
 
 // UpdateMuteTiming updates a mute timing.
 func (c *Client) UpdateMuteTiming(mt *MuteTiming) error {
-	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", mt.Name)
+	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", url.PathEscape(mt.Name))
 	req, err := json.Marshal(mt)
 	if err != nil {
 		return err
@@ -81,6 +82,6 @@ func (c *Client) UpdateMuteTiming(mt *MuteTiming) error {
 
 // DeleteMutetiming deletes a mute timing.
 func (c *Client) DeleteMuteTiming(name string) error {
-	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", name)
+	uri := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", url.PathEscape(name))
 	return c.request("DELETE", uri, nil, nil, nil)
 }
@@ -85,6 +85,19 @@ func (c *Client) NewGraphiteAnnotation(gfa *GraphiteAnnotation) (int64, error) {
 	return result.ID, err
 }
 
+// BatchCreateAnnotations creates multiple annotations, at most Config.BatchConcurrency at a time, which can be
+// lowered to stay under rate limits. The IDs and errors are returned in the same order as the annotations.
+// A successful creation has a nil error, a failed one has a zero ID.
+func (c *Client) BatchCreateAnnotations(annotations []Annotation) ([]int64, []error) {
+	ids := make([]int64, len(annotations))
+	errs := make([]error, len(annotations))
+	forEachConcurrently(len(annotations), c.batchConcurrency(), func(i int) {
+		ids[i], errs[i] = c.NewAnnotation(&annotations[i])
+	})
+
+	return ids, errs
+}
+
 // UpdateAnnotation updates all properties an existing annotation with the Annotation it is passed.
 func (c *Client) UpdateAnnotation(id int64, a *Annotation) (string, error) {
 	path := fmt.Sprintf("/api/annotations/%d", id)
@@ -105,10 +118,42 @@ func (c *Client) UpdateAnnotation(id int64, a *Annotation) (string, error) {
 	return result.Message, err
 }
 
+// AnnotationPatch holds the properties of an annotation to patch. Nil properties are left unchanged,
+// so that e.g. a pointer to an empty tag list removes all tags.
+type AnnotationPatch struct {
+	Time    *int64    `json:"time,omitempty"`
+	TimeEnd *int64    `json:"timeEnd,omitempty"`
+	Text    *string   `json:"text,omitempty"`
+	Tags    *[]string `json:"tags,omitempty"`
+}
+
 // PatchAnnotation updates one or more properties of an existing annotation that matches the specified ID.
+// Only the time, timeEnd and text set in the Annotation it is passed are sent, as well as its tags if they
+// aren't nil, leaving the others unchanged. An empty, non-nil tag list removes all tags.
+// Use PatchAnnotationFields to reset other properties, or UpdateAnnotation to replace all of them.
 func (c *Client) PatchAnnotation(id int64, a *Annotation) (string, error) {
+	patch := AnnotationPatch{}
+	if a.Time != 0 {
+		patch.Time = &a.Time
+	}
+	if a.TimeEnd != 0 {
+		patch.TimeEnd = &a.TimeEnd
+	}
+	if a.Text != "" {
+		patch.Text = &a.Text
+	}
+	if a.Tags != nil {
+		patch.Tags = &a.Tags
+	}
+
+	return c.PatchAnnotationFields(id, patch)
+}
+
+// PatchAnnotationFields updates the properties of an existing annotation that matches the specified ID
+// which are set in the AnnotationPatch it is passed.
+func (c *Client) PatchAnnotationFields(id int64, patch AnnotationPatch) (string, error) {
 	path := fmt.Sprintf("/api/annotations/%d", id)
-	data, err := json.Marshal(a)
+	data, err := json.Marshal(patch)
 	if err != nil {
 		return "", err
 	}
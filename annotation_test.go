@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -91,6 +95,34 @@ func TestNewAnnotation(t *testing.T) {
 	}
 }
 
+func TestBatchCreateAnnotations(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a Annotation
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			t.Error(err)
+		}
+		if a.Text == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "bad annotation"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"message": "Annotation added", "id": %d}`, a.Time)
+	}))
+
+	ids, errs := client.BatchCreateAnnotations([]Annotation{
+		{Text: "deploy", Time: 1},
+		{Text: "bad", Time: 2},
+		{Text: "deploy", Time: 3},
+	})
+
+	if ids[0] != 1 || ids[1] != 0 || ids[2] != 3 {
+		t.Errorf("Expected IDs in input order, got %v", ids)
+	}
+	if errs[0] != nil || errs[1] == nil || errs[2] != nil {
+		t.Errorf("Expected only the second annotation to fail, got %v", errs)
+	}
+}
+
 func TestUpdateAnnotation(t *testing.T) {
 	client := gapiTestTools(t, 200, updateAnnotationJSON)
 
@@ -110,7 +142,15 @@ func TestUpdateAnnotation(t *testing.T) {
 }
 
 func TestPatchAnnotation(t *testing.T) {
-	client := gapiTestTools(t, 200, patchAnnotationJSON)
+	var body string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body = string(data)
+		fmt.Fprint(w, patchAnnotationJSON)
+	}))
 
 	a := Annotation{
 		Text: "new text description",
@@ -125,6 +165,25 @@ func TestPatchAnnotation(t *testing.T) {
 	if res != "Annotation patched" {
 		t.Error("patch annotation response should contain the correct response message")
 	}
+	if expected := `{"text":"new text description"}`; body != expected {
+		t.Errorf("patch annotation should only send set fields - expected %s got %s", expected, body)
+	}
+
+	a = Annotation{Tags: []string{}}
+	if _, err := client.PatchAnnotation(1, &a); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"tags":[]}`; body != expected {
+		t.Errorf("patch annotation should send empty tags - expected %s got %s", expected, body)
+	}
+
+	text := ""
+	if _, err := client.PatchAnnotationFields(1, AnnotationPatch{Text: &text}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"text":""}`; body != expected {
+		t.Errorf("patch annotation should send empty text - expected %s got %s", expected, body)
+	}
 }
 
 func TestNewGraphiteAnnotation(t *testing.T) {
@@ -65,3 +65,17 @@ func (c *Client) DeleteAPIKey(id int64) (DeleteAPIKeyResponse, error) {
 	err := c.request("DELETE", path, nil, nil, &response)
 	return response, err
 }
+
+// CreateAPIKeyInOrg creates a new Grafana API key scoped to the organization whose ID it's passed.
+// It requires a client using basic auth, as API keys and service account tokens are scoped to a single organization.
+func (c *Client) CreateAPIKeyInOrg(orgID int64, request CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	if c.config.BasicAuth == nil || c.config.APIKey != "" {
+		return nil, fmt.Errorf("creating an API key in org %d requires a client using basic auth", orgID)
+	}
+
+	response, err := c.WithOrgID(orgID).CreateAPIKey(request)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -1,6 +1,7 @@
 package gapi
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -42,6 +43,39 @@ func TestCreateAPIKey(t *testing.T) {
 	t.Log(pretty.PrettyFormat(res))
 }
 
+func TestCreateAPIKeyInOrg(t *testing.T) {
+	req := CreateAPIKeyRequest{
+		Name: "key-name",
+		Role: "Admin",
+	}
+
+	client := gapiTestTools(t, 200, createAPIKeyJSON)
+	if _, err := client.CreateAPIKeyInOrg(2, req); err == nil {
+		t.Error("expected error when not using basic auth")
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"orgId": 1}`},
+		{200, `{"message":"Active organization changed"}`},
+		{200, createAPIKeyJSON},
+	})
+	client.config.APIKey = ""
+	client.config.BasicAuth = url.UserPassword("admin", "admin")
+	res, err := client.CreateAPIKeyInOrg(2, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(res))
+
+	if res.Key != "mock-api-key" {
+		t.Errorf("expected key mock-api-key; got: %s", res.Key)
+	}
+	if client.config.OrgID != 0 {
+		t.Errorf("expected the client org to be left unchanged; got: %d", client.config.OrgID)
+	}
+}
+
 func TestDeleteAPIKey(t *testing.T) {
 	client := gapiTestTools(t, 200, deleteAPIKeyJSON)
 
@@ -2,16 +2,20 @@ package gapi
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
@@ -22,6 +26,21 @@ type Client struct {
 	config  Config
 	baseURL url.URL
 	client  *http.Client
+
+	folderUIDs *resolutionCache[string]
+	teamIDs    *resolutionCache[int64]
+	etags      *etagCache
+	orgSwitch  *orgSwitcher
+	buildInfo  *buildInfoCache
+	rateLimit  *rateLimitTracker
+
+	idempotencyKey string
+	// ctx is the context set with WithContext, overriding Config.Context.
+	ctx context.Context
+	// timeout bounds each call, as set with WithTimeout.
+	timeout time.Duration
+	// readOnlyPOST marks POST requests as reads, which are sent in dry-run mode, as set with WithReadOnlyPOST.
+	readOnlyPOST bool
 }
 
 // Config contains client configuration.
@@ -35,19 +54,116 @@ type Config struct {
 	// Client provides an optional HTTP client, otherwise a default will be used.
 	Client *http.Client
 	// OrgID provides an optional organization ID
-	// with BasicAuth, it defaults to last used org
+	// with BasicAuth, it defaults to last used org. When set, the user's current org is switched to it
+	// with POST /api/user/using/:orgId before the first request, since some endpoints ignore the org header
 	// with APIKey, it is disallowed because service account tokens are scoped to a single org
 	OrgID int64
 	// NumRetries contains the number of attempted retries
 	NumRetries int
+	// MaxRetryDuration optionally caps the total time a call spends retrying. Retries stop early, returning the
+	// last error, when waiting for the next attempt would exceed it. It works alongside NumRetries.
+	MaxRetryDuration time.Duration
+	// CircuitBreaker is optionally consulted before every attempt and told about its outcome, to fail fast with
+	// ErrCircuitOpen across all callers while Grafana is down. Nil disables it.
+	CircuitBreaker CircuitBreaker
+	// RedactedLogKeys are additional JSON keys whose values are masked when
+	// request and response bodies are logged with GF_LOG set. They extend DefaultRedactedLogKeys.
+	RedactedLogKeys []string
+	// Context is the default context of requests, e.g. a root context canceled on shutdown, which cancels
+	// requests and their retries. A context set with WithContext takes precedence. It defaults to context.Background().
+	Context context.Context
+	// SkipErrorBody makes 4xx responses, except 429 Too Many Requests, fail fast with an APIError carrying only
+	// the status code, without buffering and decoding their body, e.g. for many existence checks expecting 404s.
+	// It defaults to false, returning the error message and details sent by Grafana.
+	SkipErrorBody bool
+	// StrictDecoding makes decoding fail when a response contains fields the response struct doesn't model.
+	// It defaults to false, ignoring unknown fields.
+	StrictDecoding bool
+
+	// MaxIdleConnsPerHost, MaxConnsPerHost and IdleConnTimeout tune connection pooling of the default HTTP client.
+	// They are ignored when Client is set. If none of them is set, the default client disables keep-alives.
+	// Otherwise connections are kept alive and unset fields default to
+	// GOMAXPROCS+1 idle connections per host, no limit on connections per host and a 90s idle timeout.
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+
+	// DisableHTTP2 makes the default HTTP client use HTTP/1.1 connections, and is ignored when Client is set.
+	// By default, HTTP/2 is negotiated over TLS when the server supports it. HTTP/2 multiplexes concurrent
+	// requests over a single connection, which saves connections but lets slow requests, such as long
+	// queries, delay the others.
+	DisableHTTP2 bool
+
+	// BatchConcurrency is the maximum number of concurrent requests made by batch operations such as ImportDashboards.
+	// It defaults to DefaultBatchConcurrency.
+	BatchConcurrency int
+
+	// DashboardJSONIndent indents the dashboard models returned by DashboardJSONByUID, as well as the request and
+	// response bodies logged with GF_LOG set, to make them readable. Requests are always sent as compact JSON.
+	DashboardJSONIndent bool
+
+	// DefaultFolderUID is the folder NewDashboard and ImportDashboard save dashboards to when none is specified.
+	// An empty string means no default, saving such dashboards to the General folder.
+	DefaultFolderUID string
+
+	// DryRun makes write requests (POST, PUT, PATCH and DELETE) only log what would have been sent and succeed
+	// without reaching Grafana, so that a whole plan of changes can be run through. Their results are left
+	// zero-valued, e.g. with no server-generated ID, and OnDryRun, if set, is called with each of them.
+	// Read requests are still sent, including POST requests which only read data, such as data source queries,
+	// and the requests of clients derived with WithReadOnlyPOST.
+	DryRun bool
+	// OnDryRun is called with each write request skipped because DryRun is set, from the calling goroutine.
+	OnDryRun func(DryRunRequest)
+
+	// EnableResolutionCache caches the results of FolderUIDByTitle and TeamIDByName in memory for ResolutionCacheTTL,
+	// which defaults to DefaultResolutionCacheTTL. The cache is invalidated when folders or teams are created,
+	// updated or deleted through the client. Clients made with WithAPIKey or WithBasicAuth start with an empty
+	// cache, as their credentials may belong to another org.
+	EnableResolutionCache bool
+	ResolutionCacheTTL    time.Duration
+
+	// EnableETagCache keeps the bodies of GET responses carrying an ETag in memory, and sends If-None-Match
+	// on subsequent identical requests. A 304 Not Modified response is then served from the cache.
+	EnableETagCache bool
+}
+
+// CircuitBreaker is implemented by circuit breakers, which common breaker libraries can be adapted to.
+// Allow reports whether an attempt may be made, and Record reports whether it succeeded. Network errors,
+// 5xx and 429 responses are failures, everything else is a success.
+type CircuitBreaker interface {
+	Allow() bool
+	Record(success bool)
+}
+
+// DryRunRequest describes a write request skipped because Config.DryRun is set. Results of the call which
+// made it are zero-valued.
+type DryRunRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
 }
 
+// ErrCircuitOpen is returned when Config.CircuitBreaker doesn't allow a request to be attempted.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// DefaultBatchConcurrency is the default maximum number of concurrent requests made by batch operations.
+const DefaultBatchConcurrency = 4
+
+// DefaultRedactedLogKeys are the JSON keys whose values are always masked in debug logs.
+var DefaultRedactedLogKeys = []string{"password", "secureJsonData", "basicAuthPassword", "token", "apiKey", "key"}
+
+const redactedLogValue = "[REDACTED]"
+
 // New creates a new Grafana client.
 func New(baseURL string, cfg Config) (*Client, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
 	}
+	if err := normalizeBaseURL(u); err != nil {
+		return nil, err
+	}
 
 	if cfg.BasicAuth != nil {
 		u.User = cfg.BasicAuth
@@ -55,189 +171,786 @@ func New(baseURL string, cfg Config) (*Client, error) {
 
 	cli := cfg.Client
 	if cli == nil {
-		cli = cleanhttp.DefaultClient()
+		cli = newDefaultHTTPClient(cfg)
+	}
+
+	client := &Client{
+		config:    cfg,
+		baseURL:   *u,
+		client:    cli,
+		orgSwitch: newOrgSwitcher(),
+		buildInfo: &buildInfoCache{},
+		rateLimit: &rateLimitTracker{},
+	}
+	if cfg.EnableResolutionCache {
+		client.folderUIDs = newResolutionCache[string](cfg.ResolutionCacheTTL)
+		client.teamIDs = newResolutionCache[int64](cfg.ResolutionCacheTTL)
+	}
+	if cfg.EnableETagCache {
+		client.etags = newETagCache()
+	}
+
+	return client, nil
+}
+
+// normalizeBaseURL validates a base URL and cleans its path, e.g. stripping trailing and duplicate slashes,
+// so that https://host/grafana/ and https://host/grafana build the same request URLs.
+func normalizeBaseURL(u *url.URL) error {
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("base URL %q must be absolute, such as https://grafana.example.com", u.String())
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("base URL %q must not have a query or fragment", u.String())
 	}
 
-	return &Client{
-		config:  cfg,
-		baseURL: *u,
-		client:  cli,
-	}, nil
+	rawPath := strings.TrimSuffix(path.Clean("/"+u.EscapedPath()), "/")
+	unescaped, err := url.PathUnescape(rawPath)
+	if err != nil {
+		return fmt.Errorf("base URL %q has an invalid path: %w", u.String(), err)
+	}
+	// RawPath is only kept when it differs from the default encoding of Path, e.g. with an escaped slash.
+	u.Path, u.RawPath = unescaped, ""
+	if u.EscapedPath() != rawPath {
+		u.RawPath = rawPath
+	}
+	return nil
+}
+
+func newDefaultHTTPClient(cfg Config) *http.Client {
+	transport := cleanhttp.DefaultTransport()
+	if cfg.MaxIdleConnsPerHost != 0 || cfg.MaxConnsPerHost != 0 || cfg.IdleConnTimeout != 0 {
+		transport = cleanhttp.DefaultPooledTransport()
+		if cfg.MaxIdleConnsPerHost != 0 {
+			transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		}
+		if cfg.MaxConnsPerHost != 0 {
+			transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+		}
+		if cfg.IdleConnTimeout != 0 {
+			transport.IdleConnTimeout = cfg.IdleConnTimeout
+		}
+	}
+
+	if cfg.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		// A non-nil, empty map disables HTTP/2 negotiation.
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+func (c *Client) batchConcurrency() int {
+	if c.config.BatchConcurrency > 0 {
+		return c.config.BatchConcurrency
+	}
+	return DefaultBatchConcurrency
+}
+
+// forEachConcurrently calls fn with each index from 0 to n-1, at most concurrency calls at a time, and returns
+// once all calls are done. Calls should store their results by index.
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	var (
+		sem = make(chan struct{}, concurrency)
+		wg  sync.WaitGroup
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Clone returns a copy of the client whose configuration can be changed without affecting the original.
+// Reference fields of the configuration, such as HTTPHeaders, are copied too. The HTTP client and the caches,
+// such as the ETag and build info caches, remain shared.
+func (c *Client) Clone() *Client {
+	clone := *c
+	if c.config.HTTPHeaders != nil {
+		clone.config.HTTPHeaders = make(map[string]string, len(c.config.HTTPHeaders))
+		for k, v := range c.config.HTTPHeaders {
+			clone.config.HTTPHeaders[k] = v
+		}
+	}
+	if c.config.RedactedLogKeys != nil {
+		clone.config.RedactedLogKeys = append([]string(nil), c.config.RedactedLogKeys...)
+	}
+	return &clone
 }
 
 // WithOrgID returns a new client with the provided organization ID.
 func (c Client) WithOrgID(orgID int64) *Client {
-	c.config.OrgID = orgID
-	return &c
+	clone := c.Clone()
+	clone.config.OrgID = orgID
+	return clone
+}
+
+// WithRetries returns a new client which retries failed requests up to n times, in place of Config.NumRetries,
+// e.g. WithRetries(0) for non-idempotent writes. Config.MaxRetryDuration still bounds the time spent retrying.
+func (c Client) WithRetries(n int) *Client {
+	clone := c.Clone()
+	clone.config.NumRetries = n
+	return clone
+}
+
+// WithAPIKey returns a new client authenticating with the provided API key or service account token
+// instead of the client's credentials.
+func (c Client) WithAPIKey(key string) *Client {
+	clone := c.Clone()
+	clone.config.APIKey = key
+	clone.config.BasicAuth = nil
+	clone.baseURL.User = nil
+	clone.resetResolutionCaches()
+	return clone
+}
+
+// WithBasicAuth returns a new client authenticating with the provided basic auth credentials
+// instead of the client's credentials.
+func (c Client) WithBasicAuth(user, pass string) *Client {
+	clone := c.Clone()
+	clone.config.APIKey = ""
+	clone.config.BasicAuth = url.UserPassword(user, pass)
+	clone.baseURL.User = clone.config.BasicAuth
+	// The current org is tracked per user.
+	clone.orgSwitch = newOrgSwitcher()
+	clone.resetResolutionCaches()
+	return clone
+}
+
+// resetResolutionCaches gives the client empty resolution caches, for clients whose credentials may belong to
+// another org than the client they're copied from, as cached resolutions are keyed by the configured org ID only.
+func (c *Client) resetResolutionCaches() {
+	c.folderUIDs = c.folderUIDs.empty()
+	c.teamIDs = c.teamIDs.empty()
+}
+
+// orgSwitcher tracks which organization the basic auth user was last switched to.
+// It is shared by copies of a client, as the user's current org is state kept by Grafana.
+// Requests to the user's current org are sent concurrently, and a switch to another org waits until
+// they're done, so that they can't be sent to the wrong org. Only the switch itself is exclusive.
+type orgSwitcher struct {
+	mu   sync.Mutex
+	done *sync.Cond
+	// current is the org the user was last switched to, and original the user's current org before the
+	// first switch, which requests without an org ID are sent to. They are zero until the first switch.
+	current  int64
+	original int64
+	// inFlight counts the requests being sent to the current org, and switching is set while the user's
+	// org is switched.
+	inFlight  int
+	switching bool
+}
+
+func newOrgSwitcher() *orgSwitcher {
+	s := &orgSwitcher{}
+	s.done = sync.NewCond(&s.mu)
+	return s
 }
 
+// release marks a request to the current org as done.
+func (s *orgSwitcher) release() {
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+	s.done.Broadcast()
+}
+
+// switchOrg makes the configured org, or the user's original org for clients without an org ID, the current
+// org of the basic auth user, unless it already is. The returned function must be called once the request
+// sent to that org is done, to let other requests switch orgs. It's called for each attempt of a request,
+// so that other orgs' requests can switch while it waits to be retried.
+// In dry-run mode the switch is skipped, and requests are sent to the user's current org.
+func (c *Client) switchOrg() (func(), error) {
+	s := c.orgSwitch
+	if s == nil || c.config.BasicAuth == nil || c.config.APIKey != "" {
+		return func() {}, nil
+	}
+
+	s.mu.Lock()
+	var orgID int64
+	for {
+		orgID = c.config.OrgID
+		if orgID == 0 {
+			orgID = s.original
+		}
+		if !s.switching && (orgID == 0 || orgID == s.current) {
+			s.inFlight++
+			s.mu.Unlock()
+			return s.release, nil
+		}
+		if !s.switching && c.config.DryRun && s.original != 0 {
+			s.mu.Unlock()
+			c.logDryRun("POST", fmt.Sprintf("/api/user/using/%d", orgID), nil, nil)
+			return func() {}, nil
+		}
+		if !s.switching && s.inFlight == 0 {
+			break
+		}
+		s.done.Wait()
+	}
+	s.switching = true
+	original := s.original
+	s.mu.Unlock()
+
+	original, current, err := c.switchUserOrg(orgID, original)
+
+	s.mu.Lock()
+	s.switching = false
+	if current != 0 {
+		s.original, s.current = original, current
+	}
+	if err == nil && current == orgID {
+		s.inFlight++
+	}
+	s.mu.Unlock()
+	s.done.Broadcast()
+
+	switch {
+	case err != nil:
+		return nil, err
+	case current != orgID:
+		// The switch was skipped in dry-run mode.
+		return func() {}, nil
+	}
+	return s.release, nil
+}
+
+// switchUserOrg switches the basic auth user to orgID, fetching the user's original org first if it isn't known
+// yet, and returns the user's original and current orgs.
+func (c *Client) switchUserOrg(orgID, original int64) (int64, int64, error) {
+	direct := *c
+	direct.orgSwitch = nil
+
+	current := original
+	if original == 0 {
+		var user struct {
+			OrgID int64 `json:"orgId"`
+		}
+		if err := direct.request("GET", "/api/user", nil, nil, &user); err != nil {
+			return 0, 0, fmt.Errorf("failed to get the current org: %w", err)
+		}
+		original, current = user.OrgID, user.OrgID
+		if orgID == 0 || orgID == current {
+			return original, current, nil
+		}
+	}
+	if c.config.DryRun {
+		c.logDryRun("POST", fmt.Sprintf("/api/user/using/%d", orgID), nil, nil)
+		return original, current, nil
+	}
+
+	if err := direct.request("POST", fmt.Sprintf("/api/user/using/%d", orgID), nil, nil, nil); err != nil {
+		return original, current, fmt.Errorf("failed to switch to org %d: %w", orgID, err)
+	}
+	return original, orgID, nil
+}
+
+// WithContext returns a new client whose requests use the provided context instead of Config.Context.
+func (c Client) WithContext(ctx context.Context) *Client {
+	clone := c.Clone()
+	clone.ctx = ctx
+	return clone
+}
+
+// WithTimeout returns a new client whose calls each time out after d. The timeout applies on top of the
+// client's context, Config.Context or the one set with WithContext, so that the earlier deadline wins.
+// Waits outside of requests, such as for the rate limit to reset or in WaitForReady, are bounded too.
+// It covers the whole call including its retries: a retry is not attempted if the remaining time is
+// shorter than the wait before it, and the last failure is returned instead.
+func (c Client) WithTimeout(d time.Duration) *Client {
+	clone := c.Clone()
+	clone.timeout = d
+	return clone
+}
+
+// context returns the context of the client's requests.
+func (c *Client) context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	if c.config.Context != nil {
+		return c.config.Context
+	}
+	return context.Background()
+}
+
+// callContext returns the context of a single call, which is the client's context bounded by the timeout set
+// with WithTimeout, if any. The returned function must be called once the call is done.
+func (c *Client) callContext() (context.Context, context.CancelFunc) {
+	return c.withCallTimeout(c.context())
+}
+
+// withCallTimeout bounds ctx by the timeout set with WithTimeout, if any, for calls such as polling helpers
+// which are passed their context.
+func (c *Client) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return ctx, func() {}
+}
+
+// WithReadOnlyPOST returns a new client whose POST requests only read data, such as queries sent through
+// DataSourceProxy, so that they are still sent in dry-run mode.
+func (c Client) WithReadOnlyPOST() *Client {
+	clone := c.Clone()
+	clone.readOnlyPOST = true
+	return clone
+}
+
+// WithHeaders returns a new client which sends the provided HTTP headers along with Config.HTTPHeaders,
+// e.g. to set a header on some calls only. They take precedence over the client-wide headers of the same name.
+func (c Client) WithHeaders(headers map[string]string) *Client {
+	clone := c.Clone()
+	if clone.config.HTTPHeaders == nil {
+		clone.config.HTTPHeaders = make(map[string]string, len(headers))
+	}
+	for k, v := range headers {
+		clone.config.HTTPHeaders[k] = v
+	}
+	return clone
+}
+
+// WithoutProvenance returns a new client which sets the X-Disable-Provenance header, so that
+// alerting resources it provisions remain editable in the Grafana UI.
+func (c Client) WithoutProvenance() *Client {
+	return c.WithHeaders(map[string]string{"X-Disable-Provenance": "true"})
+}
+
+// traceIDHeader is the response header Grafana uses to return the ID of the request's trace.
+const traceIDHeader = "X-Grafana-Trace-Id"
+
+// rawErrorBodyKey is the APIError.Body key holding error response bodies which aren't JSON objects.
+const rawErrorBodyKey = "_raw"
+
+// ErrRateLimited and ErrServerError classify APIErrors, which match them with errors.Is when Grafana kept
+// responding with 429 Too Many Requests or a 5xx status respectively, after any retries.
+var (
+	ErrRateLimited = errors.New("rate limited")
+	ErrServerError = errors.New("server error")
+)
+
 type APIError struct {
 	StatusCode int
 	Body       map[string]interface{}
+	// RawBody is the undecoded error response body. When set, it is used in the error message instead of Body.
+	RawBody string
+	// TraceID is the trace ID Grafana returned for the failed request, if any.
+	TraceID string
+}
+
+func newAPIError(resp *http.Response, body []byte) APIError {
+	// Error responses aren't always JSON, e.g. an HTML page from a proxy in front of Grafana.
+	var errContent map[string]interface{}
+	if err := json.Unmarshal(body, &errContent); err != nil && len(body) > 0 {
+		errContent = map[string]interface{}{rawErrorBodyKey: string(body)}
+	}
+	return APIError{
+		StatusCode: resp.StatusCode,
+		Body:       errContent,
+		TraceID:    resp.Header.Get(traceIDHeader),
+	}
+}
+
+// Message returns the human-readable message of the error response, taken from whichever of the
+// message or error fields Grafana set. It falls back to the whole body when none of them is set.
+func (e APIError) Message() string {
+	for _, key := range []string{"message", "error"} {
+		if msg, ok := e.Body[key].(string); ok && msg != "" {
+			return msg
+		}
+	}
+	if e.RawBody != "" {
+		return e.RawBody
+	}
+	if raw, ok := e.Body[rawErrorBodyKey].(string); ok {
+		return raw
+	}
+	if len(e.Body) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%v", e.Body)
+}
+
+// Is reports whether the error matches ErrRateLimited or ErrServerError.
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
 }
 
 func (e APIError) Error() string {
-	return fmt.Sprintf("status: %d, body: %v", e.StatusCode, e.Body)
+	var body interface{} = e.Body
+	if e.RawBody != "" || len(e.Body) == 0 {
+		body = e.RawBody
+	}
+	if e.TraceID != "" {
+		return fmt.Sprintf("status: %d, body: %v, trace id: %s", e.StatusCode, body, e.TraceID)
+	}
+	return fmt.Sprintf("status: %d, body: %v", e.StatusCode, body)
 }
 
 func Request[ReqT any, ResT any](c *Client, method, requestPath string, query url.Values, requestBody *ReqT) (*ResT, error) {
-	var err error
-	var requestBytes, responseBytes []byte
-
+	var requestBytes []byte
 	if requestBody != nil {
+		var err error
 		requestBytes, err = json.Marshal(requestBody)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	var resp *http.Response
-	// retry logic
-	for n := 0; n <= c.config.NumRetries; n++ {
-		req, err := c.newRequest(method, requestPath, query, bytes.NewReader(requestBytes))
-		if err != nil {
-			return nil, err
-		}
+	if c.skippedByDryRun(method) {
+		c.skipDryRun(method, requestPath, query, requestBytes)
+		return new(ResT), nil
+	}
 
-		// Wait a bit if that's not the first request
-		if n != 0 {
-			time.Sleep(time.Second * 5)
-		}
+	resp, responseBytes, err := c.do(method, requestPath, query, requestBytes)
+	if err != nil {
+		return nil, err
+	}
 
-		resp, err = c.client.Do(req)
+	// check status code.
+	if resp.StatusCode >= 400 {
+		return nil, newAPIError(resp, responseBytes)
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		responseBytes = acceptedBody(responseBytes)
+	}
 
-		// If err is not nil, retry again
-		// That's either caused by client policy, or failure to speak HTTP (such as network connectivity problem). A
-		// non-2xx status code doesn't cause an error.
-		if err != nil {
-			continue
-		}
+	var responseStruct ResT
+	err = c.decodeResponse(responseBytes, &responseStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responseStruct, nil
+}
 
-		defer resp.Body.Close()
+// GetInto sends a GET request to an arbitrary API path of the Grafana server, and decodes the JSON response
+// into out, which may be nil to discard it. It's meant for endpoints this client has no binding for, and
+// handles authentication, retries and errors like the bindings do.
+func (c *Client) GetInto(path string, query url.Values, out any) error {
+	return c.request("GET", path, query, nil, out)
+}
 
-		// read the body (even on non-successful HTTP status codes), as that's what the unit tests expect
-		responseBytes, err = ioutil.ReadAll(resp.Body)
-		// if there was an error reading the body, try again
+// PostInto sends a POST request with body marshalled to JSON, or no body if it's nil, to an arbitrary API path
+// of the Grafana server, and decodes the JSON response into out, which may be nil to discard it.
+// It's meant for endpoints this client has no binding for, like GetInto.
+func (c *Client) PostInto(path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
 		if err != nil {
-			continue
+			return err
 		}
+		reader = bytes.NewReader(data)
+	}
 
-		// Exit the loop if we have something final to return. This is anything < 500, if it's not a 429.
-		if resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
-			break
+	return c.request("POST", path, nil, reader, out)
+}
+
+func (c *Client) request(method, requestPath string, query url.Values, body io.Reader, responseStruct interface{}) error {
+	bodyContents, err := c.requestRaw(method, requestPath, query, body)
+	if err != nil {
+		return err
+	}
+
+	if responseStruct == nil {
+		return nil
+	}
+
+	return c.decodeResponse(bodyContents, responseStruct)
+}
+
+// requestRaw performs a request and returns the undecoded response body, for endpoints which don't respond with JSON.
+func (c *Client) requestRaw(method, requestPath string, query url.Values, body io.Reader) ([]byte, error) {
+	// The request data is kept in memory, as it has to be replayed when the request is retried.
+	var requestBytes []byte
+	if body != nil {
+		var err error
+		if requestBytes, err = io.ReadAll(body); err != nil {
+			return nil, err
 		}
 	}
+
+	if c.skippedByDryRun(method) {
+		c.skipDryRun(method, requestPath, query, requestBytes)
+		return nil, nil
+	}
+
+	resp, bodyContents, err := c.do(method, requestPath, query, requestBytes)
 	if err != nil {
 		return nil, err
 	}
 
 	// check status code.
 	if resp.StatusCode >= 400 {
-		var errContent map[string]interface{}
-		err := json.Unmarshal(responseBytes, &errContent)
-		if err != nil {
-			return nil, fmt.Errorf("failed to json unmarshal error response '%s' for error code %d: %w", responseBytes, resp.StatusCode, err)
-		}
-		return nil, APIError{
-			StatusCode: resp.StatusCode,
-			Body:       errContent,
-		}
+		apiErr := newAPIError(resp, bodyContents)
+		apiErr.RawBody = string(bodyContents)
+		return nil, apiErr
 	}
-
-	var responseStruct ResT
-	err = json.Unmarshal(responseBytes, &responseStruct)
-	if err != nil {
-		return nil, err
+	if resp.StatusCode == http.StatusAccepted {
+		bodyContents = acceptedBody(bodyContents)
 	}
 
-	return &responseStruct, nil
+	return bodyContents, nil
 }
 
-func (c *Client) request(method, requestPath string, query url.Values, body io.Reader, responseStruct interface{}) error {
+// acceptedBody returns the body of a 202 Accepted response if it holds a JSON object or array, and nil otherwise.
+// Operations accepted for asynchronous processing may respond with an empty or plain text status body,
+// which isn't a result to decode.
+func acceptedBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return body
+	}
+	return nil
+}
+
+// retryWait is the time waited before retrying a failed request.
+const retryWait = 5 * time.Second
+
+// do sends a request, retrying it on transport errors, server errors and rate limiting as configured by
+// NumRetries and MaxRetryDuration. It returns the last response along with its body, which has been read and closed.
+func (c *Client) do(method, requestPath string, query url.Values, requestBytes []byte) (*http.Response, []byte, error) {
 	var (
-		req          *http.Request
 		resp         *http.Response
 		err          error
 		bodyContents []byte
+		etagKey      string
+		cached       etagCacheEntry
+		isCached     bool
 	)
 
-	// If we want to retry a request that sends data, we'll need to stash the request data in memory. Otherwise, we lose it since readers cannot be replayed.
-	var bodyBuffer bytes.Buffer
-	if c.config.NumRetries > 0 && body != nil {
-		body = io.TeeReader(body, &bodyBuffer)
-	}
+	ctx, cancel := c.callContext()
+	defer cancel()
 
-	// retry logic
+	start := time.Now()
 	for n := 0; n <= c.config.NumRetries; n++ {
-		// If it's not the first request, re-use the request body we stashed earlier.
-		if n > 0 {
-			body = bytes.NewReader(bodyBuffer.Bytes())
+		// The breaker is consulted before waiting to retry, so that an open breaker fails fast.
+		if c.config.CircuitBreaker != nil && !c.config.CircuitBreaker.Allow() {
+			return nil, nil, ErrCircuitOpen
+		}
+
+		// Wait a bit if that's not the first request, unless that would exceed the retry budget or the deadline.
+		if n != 0 {
+			if c.config.MaxRetryDuration > 0 && time.Since(start)+retryWait > c.config.MaxRetryDuration {
+				break
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < retryWait {
+				break
+			}
+			timer := time.NewTimer(retryWait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, nil, ctx.Err()
+			case <-timer.C:
+			}
 		}
 
-		req, err = c.newRequest(method, requestPath, query, body)
+		var body io.Reader
+		if requestBytes != nil {
+			body = bytes.NewReader(requestBytes)
+		}
+		var req *http.Request
+		req, err = c.newRequest(ctx, method, requestPath, query, body)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
-		// Wait a bit if that's not the first request
-		if n != 0 {
-			time.Sleep(time.Second * 5)
+		if c.etags != nil && method == http.MethodGet {
+			etagKey = etagCacheKey(req)
+			if cached, isCached = c.etags.get(etagKey); isCached {
+				req.Header.Set("If-None-Match", cached.etag)
+			}
 		}
 
+		var release func()
+		if release, err = c.switchOrg(); err != nil {
+			return nil, nil, err
+		}
 		resp, err = c.client.Do(req)
 
 		// If err is not nil, retry again
 		// That's either caused by client policy, or failure to speak HTTP (such as network connectivity problem). A
 		// non-2xx status code doesn't cause an error.
 		if err != nil {
+			release()
+			c.recordAttempt(false)
 			continue
 		}
+		c.rateLimit.record(resp.Header)
 
-		defer resp.Body.Close()
-
-		// read the body (even on non-successful HTTP status codes), as that's what the unit tests expect
-		bodyContents, err = ioutil.ReadAll(resp.Body)
+		if c.config.SkipErrorBody && isClientError(resp.StatusCode) {
+			// The body is drained rather than closed unread, so that the connection can be reused.
+			bodyContents = nil
+			_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedBodySize))
+		} else {
+			// read the body (even on non-successful HTTP status codes), as that's what the unit tests expect
+			bodyContents, err = io.ReadAll(resp.Body)
+		}
+		resp.Body.Close()
+		release()
 
 		// if there was an error reading the body, try again
 		if err != nil {
+			c.recordAttempt(false)
 			continue
 		}
 
 		// Exit the loop if we have something final to return. This is anything < 500, if it's not a 429.
 		if resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			c.recordAttempt(true)
 			break
 		}
+		c.recordAttempt(false)
 	}
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	if etagKey != "" {
+		if resp.StatusCode == http.StatusNotModified && isCached {
+			bodyContents = cached.body
+		} else if etag := resp.Header.Get("ETag"); etag != "" && resp.StatusCode == http.StatusOK {
+			c.etags.set(etagKey, etag, bodyContents)
+		}
 	}
 
 	if os.Getenv("GF_LOG") != "" {
-		log.Printf("response status %d with body %v", resp.StatusCode, string(bodyContents))
+		log.Printf("response status %d with body %v", resp.StatusCode, c.redactLogBody(bodyContents))
 	}
 
-	// check status code.
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("status: %d, body: %v", resp.StatusCode, string(bodyContents))
+	return resp, bodyContents, nil
+}
+
+// maxDrainedBodySize is how much of a skipped error response body is drained to reuse the connection.
+// Larger bodies are left unread, and their connection closed.
+const maxDrainedBodySize = 64 << 10
+
+// isClientError reports whether a status code is a final 4xx error, i.e. not 429 Too Many Requests, which is retried.
+func isClientError(statusCode int) bool {
+	return statusCode >= 400 && statusCode < 500 && statusCode != http.StatusTooManyRequests
+}
+
+// recordAttempt reports the outcome of an attempt to the circuit breaker, if any.
+func (c *Client) recordAttempt(success bool) {
+	if c.config.CircuitBreaker != nil {
+		c.config.CircuitBreaker.Record(success)
 	}
+}
 
-	if responseStruct == nil {
+// skippedByDryRun reports whether a request is a write request, which isn't sent in dry-run mode.
+func (c *Client) skippedByDryRun(method string) bool {
+	if !c.config.DryRun || (method == http.MethodPost && c.readOnlyPOST) {
+		return false
+	}
+	return isWriteMethod(method)
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// skipDryRun reports a write request skipped because the client is in dry-run mode, by logging it and passing
+// it to Config.OnDryRun.
+func (c *Client) skipDryRun(method, requestPath string, query url.Values, body []byte) {
+	c.logDryRun(method, requestPath, query, body)
+	if c.config.OnDryRun != nil {
+		c.config.OnDryRun(DryRunRequest{Method: method, Path: requestPath, Query: query, Body: body})
+	}
+}
+
+// logDryRun logs a request skipped because the client is in dry-run mode.
+func (c *Client) logDryRun(method, requestPath string, query url.Values, body []byte) {
+	u := c.requestURL(requestPath, query)
+	u.User = nil
+	log.Printf("dry-run: skipping request (%s) to %s with body data: %s", method, u.String(), c.redactLogBody(body))
+}
+
+// decodeResponse unmarshals a successful response body into responseStruct.
+// Empty bodies, as returned by some endpoints on success (e.g. 204 No Content), leave responseStruct untouched.
+func (c *Client) decodeResponse(body []byte, responseStruct interface{}) error {
+	if len(bytes.TrimSpace(body)) == 0 {
 		return nil
 	}
 
-	err = json.Unmarshal(bodyContents, responseStruct)
-	if err != nil {
-		return err
+	if !c.config.StrictDecoding {
+		return json.Unmarshal(body, responseStruct)
 	}
 
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(responseStruct); err != nil {
+		return fmt.Errorf("failed to strictly decode response into %T: %w", responseStruct, err)
+	}
 	return nil
 }
 
-func (c *Client) newRequest(method, requestPath string, query url.Values, body io.Reader) (*http.Request, error) {
-	url := c.baseURL
-	url.Path = path.Join(url.Path, requestPath)
-	url.RawQuery = query.Encode()
-	req, err := http.NewRequest(method, url.String(), body)
+// mergeQueryParams merges the optional query parameters passed to write methods into a single query.
+// Later values of a parameter replace earlier ones.
+func mergeQueryParams(optionalQueryParams []url.Values) url.Values {
+	if len(optionalQueryParams) == 0 {
+		return nil
+	}
+
+	query := make(url.Values)
+	for _, params := range optionalQueryParams {
+		for key, values := range params {
+			query[key] = values
+		}
+	}
+	return query
+}
+
+// requestURL returns the URL of a request to requestPath. Segments of requestPath can be escaped with
+// url.PathEscape, so that names containing reserved characters such as slashes form a single segment.
+func (c *Client) requestURL(requestPath string, query url.Values) url.URL {
+	u := c.baseURL
+	rawPath := path.Join(u.EscapedPath(), requestPath)
+	if unescaped, err := url.PathUnescape(rawPath); err == nil {
+		u.Path, u.RawPath = unescaped, rawPath
+	} else {
+		// Not a valid escaped path, e.g. an unescaped name containing a percent sign.
+		u.Path, u.RawPath = path.Join(u.Path, requestPath), ""
+	}
+	u.RawQuery = query.Encode()
+	return u
+}
+
+func (c *Client) newRequest(ctx context.Context, method, requestPath string, query url.Values, body io.Reader) (*http.Request, error) {
+	url := c.requestURL(requestPath, query)
+
+	// The body has to be read to be logged, so replace it with a reader over the same bytes.
+	var logBody []byte
+	if os.Getenv("GF_LOG") != "" && body != nil {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		logBody = data
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
 	if err != nil {
 		return req, err
 	}
@@ -255,15 +968,73 @@ func (c *Client) newRequest(method, requestPath string, query url.Values, body i
 			req.Header.Add(k, v)
 		}
 	}
+	if c.idempotencyKey != "" && method == http.MethodPost {
+		if err := checkIdempotencyKey(c.idempotencyKey); err != nil {
+			return nil, err
+		}
+		req.Header.Set(idempotencyKeyHeader, c.idempotencyKey)
+	}
 
 	if os.Getenv("GF_LOG") != "" {
 		if body == nil {
-			log.Printf("request (%s) to %s with no body data", method, url.String())
+			log.Printf("request (%s) to %s with no body data", method, url.Redacted())
 		} else {
-			log.Printf("request (%s) to %s with body data: %s", method, url.String(), body.(*bytes.Buffer).String())
+			log.Printf("request (%s) to %s with body data: %s", method, url.Redacted(), c.redactLogBody(logBody))
 		}
 	}
 
-	req.Header.Add("Content-Type", "application/json")
+	// Requests are JSON, unless the content type is set by a header, e.g. for multipart uploads.
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Add("Content-Type", "application/json")
+	}
 	return req, err
 }
+
+// redactLogBody masks the values of secret JSON keys in a body before it is logged.
+// Bodies which aren't valid JSON are logged as is.
+func (c *Client) redactLogBody(body []byte) string {
+	var content interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return string(body)
+	}
+
+	keys := make(map[string]struct{}, len(DefaultRedactedLogKeys)+len(c.config.RedactedLogKeys))
+	for _, k := range DefaultRedactedLogKeys {
+		keys[strings.ToLower(k)] = struct{}{}
+	}
+	for _, k := range c.config.RedactedLogKeys {
+		keys[strings.ToLower(k)] = struct{}{}
+	}
+
+	var (
+		redacted []byte
+		err      error
+	)
+	if c.config.DashboardJSONIndent {
+		redacted, err = json.MarshalIndent(redactValue(content, keys), "", "  ")
+	} else {
+		redacted, err = json.Marshal(redactValue(content, keys))
+	}
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactValue(v interface{}, keys map[string]struct{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if _, ok := keys[strings.ToLower(k)]; ok {
+				v[k] = redactedLogValue
+				continue
+			}
+			v[k] = redactValue(val, keys)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val, keys)
+		}
+	}
+	return v
+}
@@ -0,0 +1,48 @@
+package gapi
+
+// DefaultCloudAPIURL is the base URL of the Grafana Cloud API, which serves the stack, access policy and
+// other cloud_*.go bindings, as opposed to the API of a Grafana instance.
+const DefaultCloudAPIURL = "https://grafana.com"
+
+// NewCloud creates a new client for the Grafana Cloud API at DefaultCloudAPIURL. Config.APIKey should be set to
+// a Grafana Cloud access policy token. Use New with another base URL to target a different Cloud API endpoint.
+// Stacks are managed with ListStacks, GetStack, CreateStack and DeleteStack, or the lower level bindings of
+// cloud_stack.go such as StackByID and UpdateStack.
+func NewCloud(cfg Config) (*Client, error) {
+	return New(DefaultCloudAPIURL, cfg)
+}
+
+// ListStacks fetches and returns the Grafana Cloud stacks the token has access to.
+func (c *Client) ListStacks() ([]*Stack, error) {
+	stacks, err := c.Stacks()
+	if err != nil {
+		return nil, err
+	}
+
+	return stacks.Items, nil
+}
+
+// GetStack fetches and returns the Grafana Cloud stack whose slug it's passed.
+func (c *Client) GetStack(slug string) (*Stack, error) {
+	stack, err := c.StackBySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stack, nil
+}
+
+// CreateStack creates a Grafana Cloud stack, and fetches and returns it once created.
+func (c *Client) CreateStack(input CreateStackInput) (*Stack, error) {
+	id, err := c.NewStack(&input)
+	if err != nil {
+		return nil, err
+	}
+
+	stack, err := c.StackByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stack, nil
+}
@@ -59,7 +59,10 @@ func (c *Client) UninstallCloudPlugin(stackSlug string, pluginSlug string) error
 
 // IsCloudPluginInstalled returns a boolean if the specified plugin is installed on the stack.
 func (c *Client) IsCloudPluginInstalled(stackSlug string, pluginSlug string) (bool, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("/api/instances/%s/plugins/%s", stackSlug, pluginSlug), nil, nil)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/instances/%s/plugins/%s", stackSlug, pluginSlug), nil, nil)
 	if err != nil {
 		return false, err
 	}
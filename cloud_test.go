@@ -0,0 +1,56 @@
+package gapi
+
+import "testing"
+
+func TestNewCloud(t *testing.T) {
+	c, err := NewCloud(Config{APIKey: "cloud-token"})
+	if err != nil {
+		t.Fatalf("expected error to be nil; got: %s", err.Error())
+	}
+
+	if c.baseURL.String() != DefaultCloudAPIURL {
+		t.Errorf("expected: %s; got: %s", DefaultCloudAPIURL, c.baseURL.String())
+	}
+	if u := c.requestURL("/api/instances", nil); u.String() != "https://grafana.com/api/instances" {
+		t.Errorf("expected: https://grafana.com/api/instances; got: %s", u.String())
+	}
+}
+
+func TestListStacks(t *testing.T) {
+	client := gapiTestTools(t, 200, getStacksJSON)
+
+	stacks, err := client.ListStacks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stacks) != 1 || stacks[0].ID != 1 {
+		t.Errorf("Not correctly parsing returned stacks - %v", stacks)
+	}
+}
+
+func TestGetStack(t *testing.T) {
+	client := gapiTestTools(t, 200, getStackJSON)
+
+	stack, err := client.GetStack("mystack")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stack.ID != 1 || stack.Slug != "mystack" {
+		t.Errorf("Not correctly parsing returned stack - %v", stack)
+	}
+}
+
+func TestCreateStack_fetchesCreatedStack(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, createStackJSON},
+		{200, getStackJSON},
+	})
+
+	stack, err := client.CreateStack(CreateStackInput{Name: "mystack", Slug: "mystack", Region: "eu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stack.ID != 1 || stack.Slug != "mystack" {
+		t.Errorf("Not correctly parsing returned stack - %v", stack)
+	}
+}
@@ -0,0 +1,106 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Correlation represents a Grafana correlation between two data sources, available since Grafana 10.
+type Correlation struct {
+	UID         string            `json:"uid,omitempty"`
+	SourceUID   string            `json:"sourceUID"`
+	TargetUID   string            `json:"targetUID,omitempty"`
+	Label       string            `json:"label"`
+	Description string            `json:"description,omitempty"`
+	Config      CorrelationConfig `json:"config"`
+}
+
+// CorrelationConfig represents the configuration of a Grafana correlation.
+type CorrelationConfig struct {
+	// Type is the type of the correlation, e.g. query.
+	Type string `json:"type"`
+	// Field is the name of the field the correlation link is attached to.
+	Field string `json:"field"`
+	// Target is the query run against the target data source.
+	Target map[string]interface{} `json:"target"`
+}
+
+type correlationResponse struct {
+	Message string      `json:"message"`
+	Result  Correlation `json:"result"`
+}
+
+// Correlations fetches the correlations whose source is the data source whose UID it's passed.
+func (c *Client) Correlations(datasourceUID string) ([]Correlation, error) {
+	correlations := make([]Correlation, 0)
+	err := c.request("GET", fmt.Sprintf("/api/datasources/uid/%s/correlations", datasourceUID), nil, nil, &correlations)
+	if err != nil {
+		return nil, correlationError(err)
+	}
+
+	return correlations, nil
+}
+
+// Correlation fetches a correlation, identified by its source data source UID and its UID.
+func (c *Client) Correlation(sourceUID, uid string) (*Correlation, error) {
+	correlation := &Correlation{}
+	err := c.request("GET", fmt.Sprintf("/api/datasources/uid/%s/correlations/%s", sourceUID, uid), nil, nil, correlation)
+	if err != nil {
+		return nil, correlationError(err)
+	}
+
+	return correlation, nil
+}
+
+// NewCorrelation creates a new correlation from its source data source.
+func (c *Client) NewCorrelation(correlation Correlation) (*Correlation, error) {
+	data, err := json.Marshal(correlation)
+	if err != nil {
+		return nil, err
+	}
+
+	result := correlationResponse{}
+	err = c.request("POST", fmt.Sprintf("/api/datasources/uid/%s/correlations", correlation.SourceUID), nil, bytes.NewBuffer(data), &result)
+	if err != nil {
+		return nil, correlationError(err)
+	}
+
+	return &result.Result, nil
+}
+
+// UpdateCorrelation updates a correlation, identified by its source data source UID and its UID.
+func (c *Client) UpdateCorrelation(correlation Correlation) (*Correlation, error) {
+	data, err := json.Marshal(correlation)
+	if err != nil {
+		return nil, err
+	}
+
+	result := correlationResponse{}
+	path := fmt.Sprintf("/api/datasources/uid/%s/correlations/%s", correlation.SourceUID, correlation.UID)
+	err = c.request("PATCH", path, nil, bytes.NewBuffer(data), &result)
+	if err != nil {
+		return nil, correlationError(err)
+	}
+
+	return &result.Result, nil
+}
+
+// DeleteCorrelation deletes a correlation, identified by its source data source UID and its UID.
+func (c *Client) DeleteCorrelation(sourceUID, uid string) error {
+	err := c.request("DELETE", fmt.Sprintf("/api/datasources/uid/%s/correlations/%s", sourceUID, uid), nil, nil, nil)
+	if err != nil {
+		return correlationError(err)
+	}
+	return nil
+}
+
+func correlationError(err error) error {
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("correlation not found, note that correlations require Grafana 10 or later: %w", err)
+	}
+	return err
+}
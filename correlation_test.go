@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	getCorrelationJSON = `{
+		"uid": "Gs3bVW0Vk",
+		"sourceUID": "loki",
+		"targetUID": "tempo",
+		"label": "Trace",
+		"description": "Jump to the trace of the log line",
+		"config": {
+			"type": "query",
+			"field": "traceID",
+			"target": {"query": "${traceID}"}
+		}
+	}`
+
+	getCorrelationsJSON = `[` + getCorrelationJSON + `]`
+
+	createdCorrelationJSON = `{
+		"message": "Correlation created",
+		"result": ` + getCorrelationJSON + `
+	}`
+)
+
+func TestCorrelations(t *testing.T) {
+	client := gapiTestTools(t, 200, getCorrelationsJSON)
+
+	correlations, err := client.Correlations("loki")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(correlations))
+
+	if len(correlations) != 1 || correlations[0].UID != "Gs3bVW0Vk" || correlations[0].Config.Field != "traceID" {
+		t.Errorf("Not correctly parsing returned correlations: %v", correlations)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Not found"}`)
+	_, err = client.Correlations("loki")
+	if err == nil || !strings.Contains(err.Error(), "Grafana 10") {
+		t.Errorf("Expected not supported error, got %v", err)
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	client := gapiTestTools(t, 200, getCorrelationJSON)
+
+	correlation, err := client.Correlation("loki", "Gs3bVW0Vk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlation.TargetUID != "tempo" || correlation.Config.Target["query"] != "${traceID}" {
+		t.Errorf("Not correctly parsing returned correlation: %v", correlation)
+	}
+}
+
+func TestNewCorrelation(t *testing.T) {
+	client := gapiTestTools(t, 200, createdCorrelationJSON)
+
+	correlation, err := client.NewCorrelation(Correlation{
+		SourceUID: "loki",
+		TargetUID: "tempo",
+		Label:     "Trace",
+		Config: CorrelationConfig{
+			Type:   "query",
+			Field:  "traceID",
+			Target: map[string]interface{}{"query": "${traceID}"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlation.UID != "Gs3bVW0Vk" {
+		t.Errorf("Invalid uid - %s, Expected %s", correlation.UID, "Gs3bVW0Vk")
+	}
+}
+
+func TestUpdateCorrelation(t *testing.T) {
+	client := gapiTestTools(t, 200, createdCorrelationJSON)
+
+	correlation, err := client.UpdateCorrelation(Correlation{UID: "Gs3bVW0Vk", SourceUID: "loki", Label: "Trace"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlation.Label != "Trace" {
+		t.Errorf("Invalid label - %s, Expected %s", correlation.Label, "Trace")
+	}
+}
+
+func TestDeleteCorrelation(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"message":"Correlation deleted"}`)
+
+	if err := client.DeleteCorrelation("loki", "Gs3bVW0Vk"); err != nil {
+		t.Error(err)
+	}
+
+	for _, code := range []int{401, 403, 404} {
+		client = gapiTestTools(t, code, "error")
+		if err := client.DeleteCorrelation("loki", "Gs3bVW0Vk"); err == nil {
+			t.Errorf("%d not detected", code)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrInvalidCredentials is returned by VerifyCredentials when Grafana rejects the client's credentials,
+	// e.g. a malformed or revoked token or a wrong password.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrExpiredCredentials is returned by VerifyCredentials when the client's API key or token has expired.
+	ErrExpiredCredentials = errors.New("expired credentials")
+)
+
+// CredentialInfo describes the identity the client's credentials authenticate as.
+type CredentialInfo struct {
+	UserID int64
+	Login  string
+	Email  string
+	Name   string
+	// OrgID, OrgName and Role are the organization requests are made in, and the identity's role in it.
+	// Legacy API keys aren't users: only their organization is reported, and their user fields and Role are empty.
+	OrgID          int64
+	OrgName        string
+	Role           OrgRole
+	IsGrafanaAdmin bool
+}
+
+// VerifyCredentials checks that the client's credentials are accepted by Grafana, and returns the identity,
+// organization and role they grant, e.g. to validate a token when configuring automation.
+// If Grafana rejects the credentials, an error wrapping ErrExpiredCredentials or ErrInvalidCredentials,
+// as well as the APIError, is returned.
+func (c *Client) VerifyCredentials() (*CredentialInfo, error) {
+	user, err := c.CurrentUser()
+	if err != nil {
+		if c.isLegacyAPIKeyError(err) {
+			return c.verifyLegacyAPIKey()
+		}
+		return nil, credentialsError(err)
+	}
+
+	info := &CredentialInfo{
+		UserID:         user.ID,
+		Login:          user.Login,
+		Email:          user.Email,
+		Name:           user.Name,
+		OrgID:          user.OrgID,
+		IsGrafanaAdmin: user.IsAdmin,
+	}
+	if c.config.OrgID != 0 {
+		info.OrgID = c.config.OrgID
+	}
+
+	orgs, err := c.CurrentUserOrgs()
+	if err != nil {
+		return nil, credentialsError(err)
+	}
+	for _, org := range orgs {
+		if org.OrgID == info.OrgID {
+			info.OrgName = org.Name
+			info.Role = org.Role
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s is not a member of org %d", info.Login, info.OrgID)
+}
+
+// isLegacyAPIKeyError reports whether an error is the failure of a user endpoint called with a legacy API key.
+// Such keys are accepted, but have no user, so Grafana fails with e.g. a 404 rather than a 401 or 403.
+func (c *Client) isLegacyAPIKeyError(err error) bool {
+	var apiErr APIError
+	if c.config.APIKey == "" || !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode != http.StatusUnauthorized && apiErr.StatusCode != http.StatusForbidden
+}
+
+// verifyLegacyAPIKey returns the organization of the client's legacy API key, read from the org endpoint,
+// which is scoped to the key's organization.
+func (c *Client) verifyLegacyAPIKey() (*CredentialInfo, error) {
+	org := Org{}
+	if err := c.request("GET", "/api/org", nil, nil, &org); err != nil {
+		return nil, credentialsError(err)
+	}
+
+	return &CredentialInfo{OrgID: org.ID, OrgName: org.Name}, nil
+}
+
+// credentialsError classifies a 401 Unauthorized APIError as invalid or expired credentials.
+func credentialsError(err error) error {
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		return err
+	}
+
+	// Grafana identifies expired keys and tokens by message, and in recent versions by message ID,
+	// such as "Expired API key" with ID api-key.expired.
+	messageID, _ := apiErr.Body["messageId"].(string)
+	if strings.HasSuffix(messageID, ".expired") || strings.Contains(strings.ToLower(apiErr.Message()), "expired") {
+		return fmt.Errorf("%w: %w", ErrExpiredCredentials, err)
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"id": 2, "login": "sa-1-provisioning", "name": "provisioning", "orgId": 1, "isGrafanaAdmin": false}`},
+		{200, `[{"orgId": 1, "name": "Main Org.", "role": "Editor"}]`},
+	})
+
+	info, err := client.VerifyCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(info))
+
+	if info.Login != "sa-1-provisioning" || info.OrgID != 1 || info.OrgName != "Main Org." || info.Role != RoleEditor {
+		t.Errorf("Not correctly parsing credential info - %v", info)
+	}
+}
+
+func TestVerifyCredentials_legacyAPIKey(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{404, `{"message": "user not found"}`},
+		{200, `{"id": 3, "name": "Payments"}`},
+	})
+
+	info, err := client.VerifyCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(info))
+
+	if info.OrgID != 3 || info.OrgName != "Payments" || info.UserID != 0 {
+		t.Errorf("Not correctly parsing legacy API key info - %v", info)
+	}
+}
+
+func TestVerifyCredentials_rejected(t *testing.T) {
+	for body, expected := range map[string]error{
+		`{"message": "Invalid API key", "messageId": "api-key.invalid"}`: ErrInvalidCredentials,
+		`{"message": "Expired API key", "messageId": "api-key.expired"}`: ErrExpiredCredentials,
+		`{"message": "Expired API key"}`:                                 ErrExpiredCredentials,
+	} {
+		client := gapiTestTools(t, 401, body)
+
+		_, err := client.VerifyCredentials()
+		if !errors.Is(err, expected) {
+			t.Errorf("expected %v for %s; got: %v", expected, body, err)
+		}
+		var apiErr APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("expected the API error to be wrapped; got: %v", err)
+		}
+	}
+
+	client := gapiTestTools(t, 403, `{"message": "Permission denied"}`)
+	if _, err := client.VerifyCredentials(); err == nil || errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected a plain API error; got: %v", err)
+	}
+}
@@ -2,17 +2,40 @@ package gapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
+// ErrDashboardVersionConflict is returned when a dashboard was changed by someone else while it was being updated.
+var ErrDashboardVersionConflict = errors.New("dashboard version conflict")
+
 // DashboardMeta represents Grafana dashboard meta.
 type DashboardMeta struct {
 	IsStarred bool   `json:"isStarred"`
 	Slug      string `json:"slug"`
 	Folder    int64  `json:"folderId"`
+	FolderUID string `json:"folderUid"`
 	URL       string `json:"url"`
+
+	Created   GrafanaTime `json:"created"`
+	Updated   GrafanaTime `json:"updated"`
+	CreatedBy string      `json:"createdBy"`
+	UpdatedBy string      `json:"updatedBy"`
+	Version   int64       `json:"version"`
+
+	// Provisioned is true for dashboards provisioned from files, which can't be changed through the API.
+	Provisioned           bool   `json:"provisioned"`
+	ProvisionedExternalID string `json:"provisionedExternalId"`
+
+	// AccessControl holds the actions the client is allowed to perform on the dashboard.
+	// It is only returned when requested with the accesscontrol=true query parameter.
+	AccessControl map[string]bool `json:"accessControl,omitempty"`
 }
 
 // DashboardSaveResponse represents the Grafana API response to creating or saving a dashboard.
@@ -24,6 +47,23 @@ type DashboardSaveResponse struct {
 	Version int64  `json:"version"`
 }
 
+// SaveResponse is implemented by responses to creating or saving an entity,
+// exposing the ID and UID the server assigned to it.
+type SaveResponse interface {
+	CreatedID() int64
+	CreatedUID() string
+}
+
+// CreatedID returns the ID of the saved dashboard.
+func (r DashboardSaveResponse) CreatedID() int64 {
+	return r.ID
+}
+
+// CreatedUID returns the UID of the saved dashboard.
+func (r DashboardSaveResponse) CreatedUID() string {
+	return r.UID
+}
+
 // Dashboard represents a Grafana dashboard.
 type Dashboard struct {
 	Meta      DashboardMeta          `json:"meta"`
@@ -32,17 +72,30 @@ type Dashboard struct {
 	FolderUID string                 `json:"folderUid"`
 	Overwrite bool                   `json:"overwrite"`
 
+	// Message is the commit message shown in the dashboard's version history.
 	// This is only used when creating a new dashboard, it will always be empty when getting a dashboard.
 	Message string `json:"message"`
+
+	// VariableDefaults overrides the default values of the dashboard's template variables, by name, when
+	// it's saved with NewDashboard. See DashboardWithVariableDefaults.
+	VariableDefaults map[string]string `json:"-"`
 }
 
 // SaveDashboard is a deprecated method for saving a Grafana dashboard. Use NewDashboard.
+// An optional commit message for the dashboard's version history can be passed.
 // Deprecated: Use NewDashboard instead.
-func (c *Client) SaveDashboard(model map[string]interface{}, overwrite bool) (*DashboardSaveResponse, error) {
+func (c *Client) SaveDashboard(model map[string]interface{}, overwrite bool, message ...string) (*DashboardSaveResponse, error) {
+	if len(message) > 1 {
+		return nil, fmt.Errorf("too many arguments. Expected 2 or 3")
+	}
+
 	wrapper := map[string]interface{}{
 		"dashboard": model,
 		"overwrite": overwrite,
 	}
+	if len(message) == 1 {
+		wrapper["message"] = message[0]
+	}
 	data, err := json.Marshal(wrapper)
 	if err != nil {
 		return nil, err
@@ -58,14 +111,225 @@ func (c *Client) SaveDashboard(model map[string]interface{}, overwrite bool) (*D
 }
 
 // NewDashboard creates a new Grafana dashboard.
-func (c *Client) NewDashboard(dashboard Dashboard) (*DashboardSaveResponse, error) {
+// If the dashboard doesn't specify a folder, it is saved to Config.DefaultFolderUID.
+// Optional query parameters are sent along with the request.
+func (c *Client) NewDashboard(dashboard Dashboard, optionalQueryParams ...url.Values) (*DashboardSaveResponse, error) {
+	if dashboard.FolderUID == "" && dashboard.FolderID == 0 {
+		dashboard.FolderUID = c.config.DefaultFolderUID
+	}
+	if len(dashboard.VariableDefaults) > 0 {
+		dashboard.Model = DashboardWithVariableDefaults(dashboard.Model, dashboard.VariableDefaults)
+	}
+
+	return c.saveDashboard(dashboard, mergeQueryParams(optionalQueryParams))
+}
+
+// DashboardWithVariableDefaults returns a copy of a dashboard model whose template variables, listed in
+// templating.list, default to the values given by variable name, e.g. to pin the env variable of a dashboard
+// cloned for a specific environment. Variables not in defaults are left untouched, and so is the passed model.
+func DashboardWithVariableDefaults(model map[string]interface{}, defaults map[string]string) map[string]interface{} {
+	templating, _ := model["templating"].(map[string]interface{})
+	list, _ := templating["list"].([]interface{})
+	if len(list) == 0 {
+		return model
+	}
+
+	newList := make([]interface{}, len(list))
+	for i, v := range list {
+		newList[i] = v
+
+		variable, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := variable["name"].(string)
+		value, ok := defaults[name]
+		if !ok {
+			continue
+		}
+		newList[i] = variableWithDefault(variable, value)
+	}
+
+	newTemplating := make(map[string]interface{}, len(templating))
+	for k, v := range templating {
+		newTemplating[k] = v
+	}
+	newTemplating["list"] = newList
+
+	newModel := make(map[string]interface{}, len(model))
+	for k, v := range model {
+		newModel[k] = v
+	}
+	newModel["templating"] = newTemplating
+
+	return newModel
+}
+
+// variableWithDefault returns a copy of a template variable whose current value, and selected option, is value.
+// Textbox and constant variables take their value from their query, which is set too.
+func variableWithDefault(variable map[string]interface{}, value string) map[string]interface{} {
+	newVariable := make(map[string]interface{}, len(variable))
+	for k, v := range variable {
+		newVariable[k] = v
+	}
+	newVariable["current"] = map[string]interface{}{
+		"selected": true,
+		"text":     value,
+		"value":    value,
+	}
+
+	if options, ok := variable["options"].([]interface{}); ok {
+		newOptions := make([]interface{}, len(options))
+		for i, o := range options {
+			newOptions[i] = o
+			if option, ok := o.(map[string]interface{}); ok {
+				newOption := make(map[string]interface{}, len(option))
+				for k, v := range option {
+					newOption[k] = v
+				}
+				newOption["selected"] = option["value"] == value
+				newOptions[i] = newOption
+			}
+		}
+		newVariable["options"] = newOptions
+	}
+
+	switch variable["type"] {
+	case "textbox", "constant":
+		newVariable["query"] = value
+	}
+
+	return newVariable
+}
+
+// CloneDashboard creates a copy of the dashboard whose UID it's passed, with the given title, in the given folder.
+// Grafana assigns a new UID to the copy.
+func (c *Client) CloneDashboard(srcUID, newTitle, folderUID string) (*DashboardSaveResponse, error) {
+	return c.CloneDashboardWithUID(srcUID, "", newTitle, folderUID)
+}
+
+// CloneDashboardWithUID creates a copy of the dashboard whose UID it's passed, with the given UID and title,
+// in the given folder. If newUID is empty, Grafana assigns a new UID to the copy.
+// The copy is never saved over an existing dashboard.
+func (c *Client) CloneDashboardWithUID(srcUID, newUID, newTitle, folderUID string) (*DashboardSaveResponse, error) {
+	src, err := c.DashboardByUID(srcUID)
+	if err != nil {
+		return nil, err
+	}
+
+	model := make(map[string]interface{}, len(src.Model))
+	for k, v := range src.Model {
+		model[k] = v
+	}
+	// Left in place, the ID and UID would make Grafana update the source dashboard.
+	delete(model, "id")
+	delete(model, "version")
+	delete(model, "uid")
+	if newUID != "" {
+		model["uid"] = newUID
+	}
+	model["title"] = newTitle
+
+	return c.NewDashboard(Dashboard{
+		Model:     model,
+		FolderUID: folderUID,
+		Message:   fmt.Sprintf("Cloned from %s", srcUID),
+	})
+}
+
+// PatchDashboard deep-merges patch into the model of the dashboard whose UID it's passed and saves it.
+// Nested objects are merged, while any other value in the patch, including arrays, replaces the current one.
+// If the dashboard was changed since it was fetched, an error wrapping ErrDashboardVersionConflict is returned.
+func (c *Client) PatchDashboard(uid string, patch map[string]interface{}) (*DashboardSaveResponse, error) {
+	current, err := c.DashboardByUID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fetched model carries the current version, so Grafana rejects the save if it changed in between.
+	resp, err := c.saveDashboard(Dashboard{
+		Model:     mergeModels(current.Model, patch),
+		FolderID:  current.FolderID,
+		FolderUID: current.Meta.FolderUID,
+	}, nil)
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed && apiErr.Body["status"] == "version-mismatch" {
+		return nil, fmt.Errorf("%w: %w", ErrDashboardVersionConflict, err)
+	}
+	return resp, err
+}
+
+// AddTagToDashboards adds a tag to the dashboards whose UIDs it's passed, at most Config.BatchConcurrency at a time.
+// Dashboards already carrying the tag are left unchanged. A failure to update a dashboard doesn't stop the others
+// from being updated: the returned map holds the errors of the dashboards which failed, by UID. The error is only
+// set when no dashboard could be updated at all, because the tag is empty or every update failed.
+func (c *Client) AddTagToDashboards(uids []string, tag string) (map[string]error, error) {
+	if tag == "" {
+		return nil, errors.New("tag must not be empty")
+	}
+
+	updateErrs := make([]error, len(uids))
+	forEachConcurrently(len(uids), c.batchConcurrency(), func(i int) {
+		updateErrs[i] = c.addTagToDashboard(uids[i], tag)
+	})
+
+	errs := make(map[string]error)
+	for i, err := range updateErrs {
+		if err != nil {
+			errs[uids[i]] = err
+		}
+	}
+	if len(uids) > 0 && len(errs) == len(uids) {
+		return errs, fmt.Errorf("failed to add tag %s to all %d dashboards", tag, len(uids))
+	}
+	return errs, nil
+}
+
+func (c *Client) addTagToDashboard(uid, tag string) error {
+	current, err := c.DashboardByUID(uid)
+	if err != nil {
+		return err
+	}
+
+	tags, _ := current.Model["tags"].([]interface{})
+	for _, t := range tags {
+		if t == tag {
+			return nil
+		}
+	}
+	current.Model["tags"] = append(tags, tag)
+
+	// The fetched model carries the current version, so Grafana rejects the save if it changed in between.
+	_, err = c.saveDashboard(Dashboard{
+		Model:     current.Model,
+		FolderID:  current.FolderID,
+		FolderUID: current.Meta.FolderUID,
+		Message:   fmt.Sprintf("Added tag %s", tag),
+	}, nil)
+	return err
+}
+
+func mergeModels(dst, src map[string]interface{}) map[string]interface{} {
+	for k, v := range src {
+		srcMap, srcOK := v.(map[string]interface{})
+		dstMap, dstOK := dst[k].(map[string]interface{})
+		if srcOK && dstOK {
+			dst[k] = mergeModels(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+	return dst
+}
+
+func (c *Client) saveDashboard(dashboard Dashboard, query url.Values) (*DashboardSaveResponse, error) {
 	data, err := json.Marshal(dashboard)
 	if err != nil {
 		return nil, err
 	}
 
 	result := &DashboardSaveResponse{}
-	err = c.request("POST", "/api/dashboards/db", nil, bytes.NewBuffer(data), &result)
+	err = c.request("POST", "/api/dashboards/db", query, bytes.NewBuffer(data), &result)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +349,10 @@ type DashboardImportRequest struct {
 	FolderUID string                 `json:"folderUid"`
 	Inputs    []DashboardImportInput `json:"inputs"`
 	Overwrite bool                   `json:"overwrite"`
+
+	// VariableDefaults overrides the default values of the dashboard's template variables, by name.
+	// See DashboardWithVariableDefaults.
+	VariableDefaults map[string]string `json:"-"`
 }
 
 type DashboardImportResponse struct {
@@ -105,50 +373,326 @@ type DashboardImportResponse struct {
 	Removed          bool   `json:"removed"`          // :false,
 }
 
-// NewDashboard creates a new Grafana dashboard.
-func (c *Client) ImportDashboard(req DashboardImportRequest) (*DashboardImportResponse, error) {
-	return Request[DashboardImportRequest, DashboardImportResponse](c, "POST", "/api/dashboards/import", nil, &req)
+// CreatedID returns the ID of the imported dashboard.
+func (r DashboardImportResponse) CreatedID() int64 {
+	return int64(r.DashboardId)
+}
+
+// CreatedUID returns the UID of the imported dashboard.
+func (r DashboardImportResponse) CreatedUID() string {
+	return r.UID
+}
+
+// ImportDashboard imports a Grafana dashboard.
+// If the request doesn't specify a folder, the dashboard is imported to Config.DefaultFolderUID.
+// Optional query parameters are sent along with the request.
+func (c *Client) ImportDashboard(req DashboardImportRequest, optionalQueryParams ...url.Values) (*DashboardImportResponse, error) {
+	if req.FolderUID == "" {
+		req.FolderUID = c.config.DefaultFolderUID
+	}
+	if len(req.VariableDefaults) > 0 {
+		req.Dashboard = DashboardWithVariableDefaults(req.Dashboard, req.VariableDefaults)
+	}
+	return Request[DashboardImportRequest, DashboardImportResponse](c, "POST", "/api/dashboards/import", mergeQueryParams(optionalQueryParams), &req)
+}
+
+// ResolveDashboardInputs fills the datasource inputs declared in the __inputs of an exported dashboard model,
+// such as DS_PROMETHEUS, with the UIDs of the data sources of the matching type, ready to be passed to
+// ImportDashboard. Other inputs, such as constants, are left out. An input fails to resolve when there is no
+// data source of its type, or more than one.
+func (c *Client) ResolveDashboardInputs(model map[string]interface{}) ([]DashboardImportInput, error) {
+	rawInputs, _ := model["__inputs"].([]interface{})
+	if len(rawInputs) == 0 {
+		return []DashboardImportInput{}, nil
+	}
+
+	dataSources, err := c.DataSources()
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[string][]*DataSource)
+	for _, ds := range dataSources {
+		byType[ds.Type] = append(byType[ds.Type], ds)
+	}
+
+	inputs := make([]DashboardImportInput, 0, len(rawInputs))
+	var errs []error
+	for _, rawInput := range rawInputs {
+		input, _ := rawInput.(map[string]interface{})
+		if input["type"] != "datasource" {
+			continue
+		}
+		name, _ := input["name"].(string)
+		pluginID, _ := input["pluginId"].(string)
+
+		candidates := byType[pluginID]
+		switch len(candidates) {
+		case 0:
+			errs = append(errs, fmt.Errorf("no %s data source found for input %s", pluginID, name))
+		case 1:
+			inputs = append(inputs, DashboardImportInput{
+				Name:     name,
+				PluginId: pluginID,
+				Type:     "datasource",
+				Value:    candidates[0].UID,
+			})
+		default:
+			names := make([]string, len(candidates))
+			for i, ds := range candidates {
+				names[i] = fmt.Sprintf("%s (%s)", ds.Name, ds.UID)
+			}
+			errs = append(errs, fmt.Errorf("multiple %s data sources found for input %s: %s", pluginID, name, strings.Join(names, ", ")))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return inputs, nil
+}
+
+// ImportDashboards imports multiple Grafana dashboards, at most Config.BatchConcurrency at a time.
+// The responses and errors are returned in the same order as the requests. A successful import
+// has a nil error, a failed one has a zero value response.
+func (c *Client) ImportDashboards(reqs []DashboardImportRequest) ([]DashboardImportResponse, []error) {
+	results := make([]DashboardImportResponse, len(reqs))
+	errs := make([]error, len(reqs))
+	forEachConcurrently(len(reqs), c.batchConcurrency(), func(i int) {
+		resp, err := c.ImportDashboard(reqs[i])
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		results[i] = *resp
+	})
+
+	return results, errs
+}
+
+// DashboardsByUIDsConcurrent fetches the full models of the dashboards whose UIDs it's passed, at most
+// concurrency at a time, or Config.BatchConcurrency if it isn't positive. The dashboards and errors are
+// returned keyed by UID.
+// Fetches are held back while the rate limit reported by Grafana is exhausted. As the rate limit is only known
+// from responses, this takes effect once a response reported the limit exhausted, or a request was rate
+// limited, and not for the fetches started before then.
+func (c *Client) DashboardsByUIDsConcurrent(uids []string, concurrency int) (map[string]*Dashboard, map[string]error) {
+	if concurrency <= 0 {
+		concurrency = c.batchConcurrency()
+	}
+
+	results := make([]*Dashboard, len(uids))
+	fetchErrs := make([]error, len(uids))
+	forEachConcurrently(len(uids), concurrency, func(i int) {
+		results[i], fetchErrs[i] = c.dashboardByUIDRateLimited(uids[i])
+	})
+
+	dashboards := make(map[string]*Dashboard, len(uids))
+	errs := make(map[string]error)
+	for i, uid := range uids {
+		if fetchErrs[i] != nil {
+			errs[uid] = fetchErrs[i]
+			continue
+		}
+		dashboards[uid] = results[i]
+	}
+
+	return dashboards, errs
+}
+
+func (c *Client) dashboardByUIDRateLimited(uid string) (*Dashboard, error) {
+	if err := c.waitForRateLimit(); err != nil {
+		return nil, err
+	}
+	return c.DashboardByUID(uid)
 }
 
 // Dashboards fetches and returns all dashboards.
 func (c *Client) Dashboards() ([]FolderDashboardSearchResponse, error) {
-	const limit = 1000
+	query := make(url.Values)
+	query.Set("type", "dash-db")
 
-	var (
-		page          = 0
-		newDashboards []FolderDashboardSearchResponse
-		dashboards    []FolderDashboardSearchResponse
-		query         = make(url.Values)
-	)
+	return c.folderDashboardSearchAll(query)
+}
 
+// WalkDashboards pages through all dashboards and calls fn for each of them, without holding more than a page
+// of search results in memory. Walking stops at the first error returned by fn, which is returned, unless it's
+// ErrStopWalk.
+func (c *Client) WalkDashboards(fn func(FolderDashboardSearchResponse) error) error {
+	query := make(url.Values)
 	query.Set("type", "dash-db")
-	query.Set("limit", fmt.Sprint(limit))
 
-	for {
-		page++
-		query.Set("page", fmt.Sprint(page))
+	err := c.folderDashboardSearchWalk(query, fn)
+	if errors.Is(err, ErrStopWalk) {
+		return nil
+	}
+	return err
+}
 
-		if err := c.request("GET", "/api/search", query, nil, &newDashboards); err != nil {
-			return nil, err
+// DashboardsModifiedSince fetches all dashboards and returns those updated at or after since,
+// e.g. for incremental backups. Search results don't carry the time dashboards were updated, so every
+// dashboard is fetched. The number of dashboards fetched concurrently is bounded by Config.BatchConcurrency.
+func (c *Client) DashboardsModifiedSince(since time.Time) ([]FolderDashboardSearchResponse, error) {
+	return c.filterDashboards(func(dashboard *Dashboard) bool {
+		return !dashboard.Meta.Updated.Time().Before(since)
+	})
+}
+
+// filterDashboards fetches all dashboards, at most Config.BatchConcurrency at a time, and returns
+// the search results of those matching.
+func (c *Client) filterDashboards(match func(*Dashboard) bool) ([]FolderDashboardSearchResponse, error) {
+	dashboards, err := c.Dashboards()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]bool, len(dashboards))
+	errs := make([]error, len(dashboards))
+	forEachConcurrently(len(dashboards), c.batchConcurrency(), func(i int) {
+		dashboard, err := c.DashboardByUID(dashboards[i].UID)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to fetch dashboard %s: %w", dashboards[i].UID, err)
+			return
 		}
+		matched[i] = match(dashboard)
+	})
 
-		dashboards = append(dashboards, newDashboards...)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
 
-		if len(newDashboards) < limit {
-			return dashboards, nil
+	matches := make([]FolderDashboardSearchResponse, 0)
+	for i, dashboard := range dashboards {
+		if matched[i] {
+			matches = append(matches, dashboard)
 		}
 	}
+
+	return matches, nil
 }
 
 // Dashboard will be removed.
 // Deprecated: Starting from Grafana v5.0. Use DashboardByUID instead.
 func (c *Client) Dashboard(slug string) (*Dashboard, error) {
-	return c.dashboard(fmt.Sprintf("/api/dashboards/db/%s", slug))
+	return c.dashboard(fmt.Sprintf("/api/dashboards/db/%s", slug), nil)
 }
 
 // DashboardByUID gets a dashboard by UID.
 func (c *Client) DashboardByUID(uid string) (*Dashboard, error) {
-	return c.dashboard(fmt.Sprintf("/api/dashboards/uid/%s", uid))
+	return c.dashboard(fmt.Sprintf("/api/dashboards/uid/%s", uid), nil)
+}
+
+// HomeDashboard fetches and returns the home dashboard users see, as configured in their preferences, or the
+// built-in default home dashboard, which has no UID, if none is configured.
+func (c *Client) HomeDashboard() (*Dashboard, error) {
+	result := struct {
+		Dashboard
+		// RedirectURI is returned instead of the dashboard when the home dashboard is a saved dashboard.
+		RedirectURI string `json:"redirectUri"`
+	}{}
+	if err := c.request("GET", "/api/dashboards/home", nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	if result.RedirectURI != "" {
+		// The URI is the dashboard's URL, such as /grafana/d/<uid>/<slug>.
+		_, uidAndSlug, found := strings.Cut(result.RedirectURI, "/d/")
+		uid, _, _ := strings.Cut(uidAndSlug, "/")
+		if !found || uid == "" {
+			return nil, fmt.Errorf("unexpected home dashboard redirect: %s", result.RedirectURI)
+		}
+		return c.DashboardByUID(uid)
+	}
+
+	dashboard := result.Dashboard
+	dashboard.FolderID = dashboard.Meta.Folder
+	return &dashboard, nil
+}
+
+// WaitForDashboard polls every interval until the dashboard whose UID it's passed exists and returns it,
+// e.g. after it was provisioned from files. Errors other than the dashboard not being found are returned
+// immediately. Use a context with a timeout or deadline to bound the wait.
+func (c *Client) WaitForDashboard(ctx context.Context, uid string, interval time.Duration) (*Dashboard, error) {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	client := c.WithContext(ctx)
+	for {
+		dashboard, err := client.DashboardByUID(uid)
+		if err == nil {
+			return dashboard, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("dashboard %s not found: %w", uid, ctx.Err())
+		}
+		var apiErr APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("dashboard %s not found: %w", uid, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// DashboardByUIDWithOptions gets a dashboard by UID, passing the given query parameters,
+// e.g. accesscontrol=true to include the access control metadata.
+func (c *Client) DashboardByUIDWithOptions(uid string, query url.Values) (*Dashboard, error) {
+	return c.dashboard(fmt.Sprintf("/api/dashboards/uid/%s", uid), query)
+}
+
+// DashboardAccessControl fetches and returns the actions, such as dashboards:write or dashboards:delete, the client
+// is allowed to perform on the dashboard whose UID it's passed, to check permissions before attempting a change.
+func (c *Client) DashboardAccessControl(uid string) (map[string]bool, error) {
+	dashboard, err := c.DashboardByUIDWithOptions(uid, url.Values{"accesscontrol": {"true"}})
+	if err != nil {
+		return nil, err
+	}
+
+	return dashboard.Meta.AccessControl, nil
+}
+
+// DashboardJSONByUID gets the JSON model of a dashboard by UID, as returned by Grafana.
+// Unlike DashboardByUID, the model isn't decoded, which preserves its key order and formatting.
+// With Config.DashboardJSONIndent set, the model is indented.
+func (c *Client) DashboardJSONByUID(uid string) ([]byte, error) {
+	data, err := c.requestRaw("GET", fmt.Sprintf("/api/dashboards/uid/%s", uid), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Dashboard json.RawMessage `json:"dashboard"`
+	}{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	if c.config.DashboardJSONIndent {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, result.Dashboard, "", "  "); err != nil {
+			return nil, err
+		}
+		return indented.Bytes(), nil
+	}
+
+	return result.Dashboard, nil
+}
+
+// IsDashboardProvisioned reports whether the dashboard whose UID it's passed is provisioned.
+// An error is returned if the dashboard doesn't exist.
+func (c *Client) IsDashboardProvisioned(uid string) (bool, error) {
+	dashboard, err := c.DashboardByUID(uid)
+	if err != nil {
+		return false, err
+	}
+
+	return dashboard.Meta.Provisioned, nil
 }
 
 // DashboardsByIDs uses the folder and dashboard search endpoint to find
@@ -166,9 +710,24 @@ func (c *Client) DashboardsByIDs(ids []int64) ([]FolderDashboardSearchResponse,
 	return c.FolderDashboardSearch(params)
 }
 
-func (c *Client) dashboard(path string) (*Dashboard, error) {
+// DashboardsByUIDs uses the folder and dashboard search endpoint to find
+// dashboards by list of dashboard UIDs. UIDs which don't match a dashboard are left out.
+func (c *Client) DashboardsByUIDs(uids []string) ([]FolderDashboardSearchResponse, error) {
+	// Without any UID to filter by, the search would return all dashboards.
+	if len(uids) == 0 {
+		return []FolderDashboardSearchResponse{}, nil
+	}
+
+	params := url.Values{
+		"type":          {"dash-db"},
+		"dashboardUIDs": uids,
+	}
+	return c.folderDashboardSearchAll(params)
+}
+
+func (c *Client) dashboard(path string, query url.Values) (*Dashboard, error) {
 	result := &Dashboard{}
-	err := c.request("GET", path, nil, nil, &result)
+	err := c.request("GET", path, query, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -177,17 +736,31 @@ func (c *Client) dashboard(path string) (*Dashboard, error) {
 	return result, err
 }
 
+// DashboardDeleteResponse represents the response to a dashboard deletion.
+type DashboardDeleteResponse struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
 // DeleteDashboard will be removed.
 // Deprecated: Starting from Grafana v5.0. Use DeleteDashboardByUID instead.
 func (c *Client) DeleteDashboard(slug string) error {
-	return c.deleteDashboard(fmt.Sprintf("/api/dashboards/db/%s", slug))
+	_, err := c.deleteDashboard(fmt.Sprintf("/api/dashboards/db/%s", slug))
+	return err
 }
 
-// DeleteDashboardByUID deletes a dashboard by UID.
-func (c *Client) DeleteDashboardByUID(uid string) error {
+// DeleteDashboardByUID deletes a dashboard by UID, and returns the title of the deleted dashboard.
+func (c *Client) DeleteDashboardByUID(uid string) (*DashboardDeleteResponse, error) {
 	return c.deleteDashboard(fmt.Sprintf("/api/dashboards/uid/%s", uid))
 }
 
-func (c *Client) deleteDashboard(path string) error {
-	return c.request("DELETE", path, nil, nil, nil)
+func (c *Client) deleteDashboard(path string) (*DashboardDeleteResponse, error) {
+	result := &DashboardDeleteResponse{}
+	err := c.request("DELETE", path, nil, nil, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
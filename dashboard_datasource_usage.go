@@ -0,0 +1,41 @@
+package gapi
+
+// DashboardsUsingDatasource fetches all dashboards and returns those whose models reference the data source
+// whose UID it's passed, e.g. to find the dashboards a data source deletion would break.
+// Grafana can't be queried for this, so every dashboard model is fetched and inspected. The number of
+// dashboards fetched concurrently is bounded by Config.BatchConcurrency.
+func (c *Client) DashboardsUsingDatasource(datasourceUID string) ([]FolderDashboardSearchResponse, error) {
+	return c.filterDashboards(func(dashboard *Dashboard) bool {
+		return modelUsesDatasource(dashboard.Model, datasourceUID)
+	})
+}
+
+// modelUsesDatasource walks a dashboard model, including panels nested in rows, queries and template variables,
+// looking for a datasource reference ({"datasource": {"uid": ...}}) or datasourceUid field with the given UID.
+func modelUsesDatasource(model interface{}, datasourceUID string) bool {
+	switch v := model.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			switch key {
+			case "datasource":
+				if ref, ok := value.(map[string]interface{}); ok && ref["uid"] == datasourceUID {
+					return true
+				}
+			case "datasourceUid":
+				if value == datasourceUID {
+					return true
+				}
+			}
+			if modelUsesDatasource(value, datasourceUID) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if modelUsesDatasource(item, datasourceUID) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	dashboardsUsingDatasourceSearchJSON = `[
+		{"id": 1, "uid": "row-panel", "title": "Row panel", "type": "dash-db"},
+		{"id": 2, "uid": "other", "title": "Other", "type": "dash-db"},
+		{"id": 3, "uid": "variable", "title": "Variable", "type": "dash-db"}
+	]`
+	rowPanelDashboardJSON = `{"dashboard": {"uid": "row-panel", "panels": [
+		{"type": "row", "panels": [{"type": "timeseries", "targets": [{"refId": "A", "datasource": {"type": "prometheus", "uid": "prom"}}]}]}
+	]}}`
+	otherDashboardJSON = `{"dashboard": {"uid": "other", "panels": [
+		{"type": "timeseries", "datasource": {"type": "loki", "uid": "loki"}}
+	]}}`
+	variableDashboardJSON = `{"dashboard": {"uid": "variable", "templating": {"list": [
+		{"name": "instance", "type": "query", "datasource": {"type": "prometheus", "uid": "prom"}}
+	]}}}`
+)
+
+func TestDashboardsUsingDatasource(t *testing.T) {
+	responses := map[string]string{
+		"/api/search":                   dashboardsUsingDatasourceSearchJSON,
+		"/api/dashboards/uid/row-panel": rowPanelDashboardJSON,
+		"/api/dashboards/uid/other":     otherDashboardJSON,
+		"/api/dashboards/uid/variable":  variableDashboardJSON,
+	}
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+
+	dashboards, err := client.DashboardsUsingDatasource("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if len(dashboards) != 2 || dashboards[0].UID != "row-panel" || dashboards[1].UID != "variable" {
+		t.Errorf("expected dashboards row-panel and variable; got %v", dashboards)
+	}
+
+	delete(responses, "/api/dashboards/uid/other")
+	if _, err := client.DashboardsUsingDatasource("prom"); err == nil {
+		t.Error("expected an error when a dashboard can't be fetched")
+	}
+}
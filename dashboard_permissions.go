@@ -8,20 +8,16 @@ import (
 
 // DashboardPermission has information such as a dashboard, user, team, role and permission.
 type DashboardPermission struct {
-	DashboardID  int64  `json:"dashboardId"`
-	DashboardUID string `json:"uid"`
-	UserID       int64  `json:"userId"`
-	TeamID       int64  `json:"teamId"`
-	Role         string `json:"role"`
-	IsFolder     bool   `json:"isFolder"`
-	Inherited    bool   `json:"inherited"`
-
-	// Permission levels are
-	// 1 = View
-	// 2 = Edit
-	// 4 = Admin
-	Permission     int64  `json:"permission"`
-	PermissionName string `json:"permissionName"`
+	DashboardID  int64   `json:"dashboardId"`
+	DashboardUID string  `json:"uid"`
+	UserID       int64   `json:"userId"`
+	TeamID       int64   `json:"teamId"`
+	Role         OrgRole `json:"role"`
+	IsFolder     bool    `json:"isFolder"`
+	Inherited    bool    `json:"inherited"`
+
+	Permission     PermissionLevel `json:"permission"`
+	PermissionName string          `json:"permissionName"`
 }
 
 // DashboardPermissions fetches and returns the permissions for the dashboard whose ID it's passed.
@@ -59,3 +55,10 @@ func (c *Client) UpdateDashboardPermissionsByUID(uid string, items *PermissionIt
 
 	return c.request("POST", path, nil, bytes.NewBuffer(data), nil)
 }
+
+// ClearDashboardPermissions removes all permissions set on the dashboard whose UID it's passed, leaving only
+// the permissions it inherits from its folder. Grafana replaces the dashboard's own permissions with the
+// items posted, so an empty list clears them.
+func (c *Client) ClearDashboardPermissions(uid string) error {
+	return c.UpdateDashboardPermissionsByUID(uid, &PermissionItems{Items: []*PermissionItem{}})
+}
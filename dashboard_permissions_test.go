@@ -1,6 +1,9 @@
 package gapi
 
 import (
+	"fmt"
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -217,3 +220,25 @@ func TestUpdateDashboardPermissionsByUID(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestClearDashboardPermissions(t *testing.T) {
+	var body string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/dashboards/uid/nErXDvCkzz/permissions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body = string(data)
+		fmt.Fprint(w, updateDashboardPermissionsJSON)
+	}))
+
+	if err := client.ClearDashboardPermissions("nErXDvCkzz"); err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"items":[]}` {
+		t.Errorf("expected an empty list of items; got %s", body)
+	}
+}
@@ -1,8 +1,17 @@
 package gapi
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gobs/pretty"
 )
@@ -44,7 +53,9 @@ const (
 		"meta": {
 			"isStarred": false,
 			"url": "/d/cIBgcSjkk/production-overview",
-			"slug": "production-overview"
+			"slug": "production-overview",
+			"created": "2023-06-12T15:04:05Z",
+			"updated": "2023-06-13T15:04:05Z"
 		}
 	}`
 
@@ -146,6 +157,9 @@ func TestDashboardGet(t *testing.T) {
 	if !ok || uid != "cIBgcSjkk" {
 		t.Fatalf("Invalid UID - %s, Expected %s", uid, "cIBgcSjkk")
 	}
+	if !resp.Meta.Updated.Time().After(resp.Meta.Created.Time()) || resp.Meta.Created.Time().Year() != 2023 {
+		t.Errorf("Invalid meta timestamps - created %s, updated %s", resp.Meta.Created.Time(), resp.Meta.Updated.Time())
+	}
 
 	for _, code := range []int{401, 403, 404} {
 		client = gapiTestTools(t, code, "error")
@@ -169,12 +183,18 @@ func TestDashboardDelete(t *testing.T) {
 		t.Error(err)
 	}
 
-	client = gapiTestTools(t, 200, "")
-	err = client.DeleteDashboardByUID("cIBgcSjkk")
+	client = gapiTestTools(t, 200, `{"id": 2, "title": "Production Overview", "message": "Dashboard Production Overview deleted"}`)
+	resp, err := client.DeleteDashboardByUID("cIBgcSjkk")
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	t.Log(pretty.PrettyFormat(resp))
+
+	if resp.ID != 2 || resp.Title != "Production Overview" || resp.Message != "Dashboard Production Overview deleted" {
+		t.Error("Not correctly parsing returned deletion message.")
+	}
+
 	for _, code := range []int{401, 403, 404, 412} {
 		client = gapiTestTools(t, code, "error")
 
@@ -184,7 +204,7 @@ func TestDashboardDelete(t *testing.T) {
 		}
 
 		client = gapiTestTools(t, code, "error")
-		err = client.DeleteDashboardByUID("cIBgcSjkk")
+		_, err = client.DeleteDashboardByUID("cIBgcSjkk")
 		if err == nil {
 			t.Errorf("%d not detected", code)
 		}
@@ -221,3 +241,704 @@ func TestDashboards(t *testing.T) {
 		t.Error("Not correctly parsing returned dashboards.")
 	}
 }
+
+func TestHomeDashboard(t *testing.T) {
+	client := gapiTestTools(t, 200, `{
+		"dashboard": {"title": "Home", "panels": []},
+		"meta": {"isHome": true, "canSave": false, "slug": ""}
+	}`)
+
+	dashboard, err := client.HomeDashboard()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboard))
+
+	if dashboard.Model["title"] != "Home" || dashboard.Model["uid"] != nil {
+		t.Errorf("Expected the built-in home dashboard, got %v", dashboard.Model)
+	}
+
+	var paths []string
+	client = gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/api/dashboards/home" {
+			fmt.Fprint(w, `{"redirectUri": "/grafana/d/cIBgcSjkk/production-overview"}`)
+			return
+		}
+		fmt.Fprint(w, getDashboardResponse)
+	}))
+
+	dashboard, err = client.HomeDashboard()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboard.Model["uid"] != "cIBgcSjkk" || len(paths) != 2 || paths[1] != "/api/dashboards/uid/cIBgcSjkk" {
+		t.Errorf("Expected the configured home dashboard to be fetched, got %v after requesting %v", dashboard.Model, paths)
+	}
+}
+
+func TestDashboardsByUIDs(t *testing.T) {
+	var query url.Values
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		fmt.Fprint(w, "["+getDashboardsJSON+"]")
+	}))
+
+	dashboards, err := client.DashboardsByUIDs([]string{"RGAPB1cZz", "cIBgcSjkk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if uids := query["dashboardUIDs"]; len(uids) != 2 || uids[0] != "RGAPB1cZz" || uids[1] != "cIBgcSjkk" {
+		t.Errorf("Expected repeated dashboardUIDs query parameters, got %v", query)
+	}
+	if len(dashboards) != 1 || dashboards[0].UID != "RGAPB1cZz" {
+		t.Errorf("Not correctly parsing returned dashboards - %v", dashboards)
+	}
+
+	query = nil
+	dashboards, err = client.DashboardsByUIDs(nil)
+	if err != nil || len(dashboards) != 0 || query != nil {
+		t.Errorf("Expected no search without UIDs, got %v, %v after querying %v", dashboards, err, query)
+	}
+}
+
+func TestWalkDashboards(t *testing.T) {
+	mockData := strings.Repeat(getDashboardsJSON+",", 1000) // make 1000 dashboards.
+	mockData = "[" + mockData[:len(mockData)-1] + "]"       // remove trailing comma; make a json list.
+
+	// Walking stops before the third page is requested.
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, mockData},
+		{200, mockData},
+	})
+
+	walked := 0
+	err := client.WalkDashboards(func(dashboard FolderDashboardSearchResponse) error {
+		if dashboard.Title != "Grafana Stats" {
+			t.Errorf("Not correctly parsing walked dashboard - %v", dashboard)
+		}
+		walked++
+		if walked == 1500 {
+			return ErrStopWalk
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if walked != 1500 {
+		t.Errorf("Expected walking to stop after 1500 dashboards, walked %d", walked)
+	}
+
+	client = gapiTestTools(t, 200, mockData)
+	expected := errors.New("backup failed")
+	err = client.WalkDashboards(func(FolderDashboardSearchResponse) error {
+		return expected
+	})
+	if !errors.Is(err, expected) {
+		t.Errorf("Expected the callback's error, got %v", err)
+	}
+}
+
+func TestDashboardSaveResponses(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, createdAndUpdateDashboardResponse},
+		{200, importedResponse},
+	})
+
+	saved, err := client.NewDashboard(Dashboard{Model: map[string]interface{}{"title": "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := client.ImportDashboard(DashboardImportRequest{Dashboard: map[string]interface{}{"title": "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resp := range []SaveResponse{saved, imported} {
+		if resp.CreatedUID() != "nErXDvCkzz" {
+			t.Errorf("Invalid uid - %s, Expected %s", resp.CreatedUID(), "nErXDvCkzz")
+		}
+		if resp.CreatedID() != 1 {
+			t.Errorf("Invalid id - %d, Expected %d", resp.CreatedID(), 1)
+		}
+	}
+}
+
+func TestDashboardImports(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "broken") {
+			w.WriteHeader(400)
+			fmt.Fprint(w, `{"message":"bad request"}`)
+			return
+		}
+		fmt.Fprint(w, importedResponse)
+	}))
+	client.config.BatchConcurrency = 2
+
+	reqs := []DashboardImportRequest{
+		{Dashboard: map[string]interface{}{"title": "test"}},
+		{Dashboard: map[string]interface{}{"title": "broken"}},
+		{Dashboard: map[string]interface{}{"title": "test"}},
+	}
+
+	resps, errs := client.ImportDashboards(reqs)
+	if len(resps) != len(reqs) || len(errs) != len(reqs) {
+		t.Fatalf("Expected %d responses and errors, got %d and %d", len(reqs), len(resps), len(errs))
+	}
+
+	t.Log(pretty.PrettyFormat(resps))
+
+	for _, i := range []int{0, 2} {
+		if errs[i] != nil {
+			t.Errorf("Unexpected error for import %d: %s", i, errs[i])
+		}
+		if resps[i].UID != "nErXDvCkzz" {
+			t.Errorf("Invalid uid for import %d - %s, Expected %s", i, resps[i].UID, "nErXDvCkzz")
+		}
+	}
+	if errs[1] == nil {
+		t.Error("400 not detected")
+	}
+	if resps[1].UID != "" {
+		t.Errorf("Expected empty response for failed import, got uid %s", resps[1].UID)
+	}
+}
+
+func TestNewDashboard_queryParams(t *testing.T) {
+	var query url.Values
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+
+	model := map[string]interface{}{"title": "test"}
+	params := url.Values{"foo": {"bar"}}
+	if _, err := client.NewDashboard(Dashboard{Model: model}, params, url.Values{"baz": {"qux"}}); err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("foo") != "bar" || query.Get("baz") != "qux" {
+		t.Errorf("Expected query parameters to be sent, got %v", query)
+	}
+
+	if _, err := client.ImportDashboard(DashboardImportRequest{Dashboard: model}, params); err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("foo") != "bar" {
+		t.Errorf("Expected query parameters to be sent, got %v", query)
+	}
+}
+
+func TestNewDashboard_variableDefaults(t *testing.T) {
+	var saved Dashboard
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+
+	model := map[string]interface{}{
+		"title": "test",
+		"templating": map[string]interface{}{
+			"list": []interface{}{
+				map[string]interface{}{
+					"name":    "env",
+					"type":    "custom",
+					"current": map[string]interface{}{"text": "dev", "value": "dev"},
+					"options": []interface{}{
+						map[string]interface{}{"text": "dev", "value": "dev", "selected": true},
+						map[string]interface{}{"text": "prod", "value": "prod", "selected": false},
+					},
+				},
+				map[string]interface{}{"name": "prefix", "type": "textbox", "query": "a"},
+				map[string]interface{}{"name": "instance", "type": "query", "query": "up"},
+			},
+		},
+	}
+	_, err := client.NewDashboard(Dashboard{
+		Model:            model,
+		VariableDefaults: map[string]string{"env": "prod", "prefix": "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(saved.Model))
+
+	list := saved.Model["templating"].(map[string]interface{})["list"].([]interface{})
+	env := list[0].(map[string]interface{})
+	if env["current"].(map[string]interface{})["value"] != "prod" {
+		t.Errorf("Expected env to default to prod, got %v", env["current"])
+	}
+	options := env["options"].([]interface{})
+	if options[0].(map[string]interface{})["selected"] != false || options[1].(map[string]interface{})["selected"] != true {
+		t.Errorf("Expected the prod option to be selected, got %v", options)
+	}
+	if prefix := list[1].(map[string]interface{}); prefix["query"] != "b" {
+		t.Errorf("Expected prefix to default to b, got %v", prefix)
+	}
+	if instance := list[2].(map[string]interface{}); instance["current"] != nil || instance["query"] != "up" {
+		t.Errorf("Expected instance to be left untouched, got %v", instance)
+	}
+
+	original := model["templating"].(map[string]interface{})["list"].([]interface{})[0].(map[string]interface{})
+	if original["current"].(map[string]interface{})["value"] != "dev" {
+		t.Error("Expected the passed model to be left unchanged")
+	}
+}
+
+func TestAddTagToDashboards(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		saved = make(map[string][]interface{})
+	)
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/dashboards/uid/untagged":
+			fmt.Fprint(w, `{"dashboard": {"uid": "untagged", "version": 1}, "meta": {"folderUid": "ops"}}`)
+		case r.Method == "GET" && r.URL.Path == "/api/dashboards/uid/tagged":
+			fmt.Fprint(w, `{"dashboard": {"uid": "tagged", "tags": ["team-ops"]}}`)
+		case r.Method == "POST":
+			var dashboard Dashboard
+			if err := json.NewDecoder(r.Body).Decode(&dashboard); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			saved[dashboard.Model["uid"].(string)] = dashboard.Model["tags"].([]interface{})
+			mu.Unlock()
+			fmt.Fprint(w, createdAndUpdateDashboardResponse)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	errs, err := client.AddTagToDashboards([]string{"untagged", "tagged", "missing"}, "team-ops")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(saved))
+
+	if len(errs) != 1 || errs["missing"] == nil {
+		t.Errorf("Expected an error for the missing dashboard only, got %v", errs)
+	}
+	if len(saved) != 1 || len(saved["untagged"]) != 1 || saved["untagged"][0] != "team-ops" {
+		t.Errorf("Expected only the untagged dashboard to be saved with the tag, got %v", saved)
+	}
+
+	if _, err := client.AddTagToDashboards([]string{"untagged"}, ""); err == nil {
+		t.Error("Expected an error for an empty tag")
+	}
+	if errs, err := client.AddTagToDashboards([]string{"missing", "gone"}, "team-ops"); err == nil || len(errs) != 2 {
+		t.Errorf("Expected an error when every update fails, got %v, %v", errs, err)
+	}
+}
+
+func TestCloneDashboard(t *testing.T) {
+	var saved Dashboard
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, getDashboardResponse)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+
+	if _, err := client.CloneDashboard("cIBgcSjkk", "Staging Overview", "staging"); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(saved))
+
+	for _, key := range []string{"id", "uid", "version"} {
+		if _, ok := saved.Model[key]; ok {
+			t.Errorf("Expected %s to be stripped from the clone", key)
+		}
+	}
+	if saved.Model["title"] != "Staging Overview" || saved.FolderUID != "staging" || saved.Overwrite {
+		t.Errorf("Unexpected clone: %v", saved)
+	}
+
+	if _, err := client.CloneDashboardWithUID("cIBgcSjkk", "staging-overview", "Staging Overview", "staging"); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Model["uid"] != "staging-overview" {
+		t.Errorf("Expected uid staging-overview, got %v", saved.Model["uid"])
+	}
+}
+
+func TestDashboardDefaultFolder(t *testing.T) {
+	var folderUIDs []string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			FolderUID string `json:"folderUid"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		folderUIDs = append(folderUIDs, body.FolderUID)
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+	client.config.DefaultFolderUID = "provisioned"
+
+	model := map[string]interface{}{"title": "test"}
+	if _, err := client.NewDashboard(Dashboard{Model: model}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.NewDashboard(Dashboard{Model: model, FolderUID: "l3KqBxCMz"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ImportDashboard(DashboardImportRequest{Dashboard: model}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"provisioned", "l3KqBxCMz", "provisioned"}
+	if strings.Join(folderUIDs, ",") != strings.Join(expected, ",") {
+		t.Errorf("Invalid folder uids - %v, Expected %v", folderUIDs, expected)
+	}
+}
+
+func TestPatchDashboard(t *testing.T) {
+	var saved Dashboard
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{
+				"dashboard": {
+					"uid": "cIBgcSjkk",
+					"title": "Production Overview",
+					"tags": ["prod"],
+					"time": {"from": "now-6h", "to": "now"},
+					"version": 3
+				},
+				"meta": {"folderId": 2, "folderUid": "l3KqBxCMz"}
+			}`)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+
+	_, err := client.PatchDashboard("cIBgcSjkk", map[string]interface{}{
+		"tags": []interface{}{"staging"},
+		"time": map[string]interface{}{"from": "now-1h"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(saved))
+
+	if saved.Model["title"] != "Production Overview" || saved.Model["version"] != float64(3) {
+		t.Errorf("Unpatched fields not kept - %v", saved.Model)
+	}
+	if tags := saved.Model["tags"].([]interface{}); len(tags) != 1 || tags[0] != "staging" {
+		t.Errorf("Arrays not replaced - %v", tags)
+	}
+	if tr := saved.Model["time"].(map[string]interface{}); tr["from"] != "now-1h" || tr["to"] != "now" {
+		t.Errorf("Objects not merged - %v", tr)
+	}
+	if saved.FolderUID != "l3KqBxCMz" || saved.Overwrite {
+		t.Errorf("Invalid save request - folder %s, overwrite %t", saved.FolderUID, saved.Overwrite)
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, getDashboardResponse},
+		{412, `{"message":"The dashboard has been changed by someone else","status":"version-mismatch"}`},
+	})
+	_, err = client.PatchDashboard("cIBgcSjkk", map[string]interface{}{"title": "test"})
+	if !errors.Is(err, ErrDashboardVersionConflict) {
+		t.Errorf("Expected version conflict, got %v", err)
+	}
+}
+
+func TestDashboardSaveMessage(t *testing.T) {
+	var messages []string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Message string `json:"message"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		messages = append(messages, body.Message)
+		fmt.Fprint(w, createdAndUpdateDashboardResponse)
+	}))
+
+	model := map[string]interface{}{"title": "test"}
+	if _, err := client.NewDashboard(Dashboard{Model: model, Message: "commit 0d1a2b3"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.SaveDashboard(model, false, "commit 4c5d6e7"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.SaveDashboard(model, false, "a", "b"); err == nil {
+		t.Error("Expected error for too many messages")
+	}
+
+	expected := []string{"commit 0d1a2b3", "commit 4c5d6e7"}
+	if strings.Join(messages, ",") != strings.Join(expected, ",") {
+		t.Errorf("Invalid messages - %v, Expected %v", messages, expected)
+	}
+}
+
+func TestIsDashboardProvisioned(t *testing.T) {
+	client := gapiTestTools(t, 200, `{
+		"dashboard": {"uid": "cIBgcSjkk", "title": "Production Overview"},
+		"meta": {"provisioned": true, "provisionedExternalId": "production-overview.json"}
+	}`)
+
+	provisioned, err := client.IsDashboardProvisioned("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !provisioned {
+		t.Error("Expected dashboard to be provisioned")
+	}
+
+	client = gapiTestTools(t, 200, getDashboardResponse)
+	provisioned, err = client.IsDashboardProvisioned("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provisioned {
+		t.Error("Expected dashboard not to be provisioned")
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Dashboard not found"}`)
+	if _, err = client.IsDashboardProvisioned("cIBgcSjkk"); err == nil {
+		t.Error("404 not detected")
+	}
+}
+
+func TestDashboardByUIDWithOptions(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("accesscontrol") != "true" {
+			t.Errorf("Expected accesscontrol query parameter, got %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{
+			"dashboard": {"uid": "cIBgcSjkk", "title": "Production Overview"},
+			"meta": {"accessControl": {"dashboards:read": true, "dashboards:write": false}}
+		}`)
+	}))
+
+	resp, err := client.DashboardByUIDWithOptions("cIBgcSjkk", url.Values{"accesscontrol": {"true"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Meta.AccessControl["dashboards:read"] || resp.Meta.AccessControl["dashboards:write"] {
+		t.Errorf("Not correctly parsing access control metadata - %v", resp.Meta.AccessControl)
+	}
+}
+
+func TestDashboardAccessControl(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("accesscontrol") != "true" {
+			t.Errorf("Expected accesscontrol query parameter, got %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{
+			"dashboard": {"uid": "cIBgcSjkk", "title": "Production Overview"},
+			"meta": {"accessControl": {"dashboards:read": true, "dashboards:write": true, "dashboards:delete": false}}
+		}`)
+	}))
+
+	actions, err := client.DashboardAccessControl("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 3 || !actions["dashboards:write"] || actions["dashboards:delete"] {
+		t.Errorf("Not correctly parsing access control metadata - %v", actions)
+	}
+}
+
+func TestDashboardJSONByUID(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"dashboard":{"uid":"cIBgcSjkk","title":"Production Overview","id":1},"meta":{}}`)
+
+	data, err := client.DashboardJSONByUID("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"uid":"cIBgcSjkk","title":"Production Overview","id":1}`
+	if string(data) != expected {
+		t.Errorf("Invalid dashboard JSON - %s, Expected %s", data, expected)
+	}
+
+	client = gapiTestTools(t, 200, `{"dashboard":{"uid":"cIBgcSjkk","title":"Production Overview","id":1},"meta":{}}`)
+	client.config.DashboardJSONIndent = true
+	data, err = client.DashboardJSONByUID("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "{\n  \"uid\": \"cIBgcSjkk\",\n  \"title\": \"Production Overview\",\n  \"id\": 1\n}"
+	if string(data) != expected {
+		t.Errorf("Invalid indented dashboard JSON - %s, Expected %s", data, expected)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Dashboard not found"}`)
+	if _, err = client.DashboardJSONByUID("cIBgcSjkk"); err == nil {
+		t.Error("404 not detected")
+	}
+}
+
+func TestWaitForDashboard(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{404, `{"message":"Dashboard not found"}`},
+		{404, `{"message":"Dashboard not found"}`},
+		{200, getDashboardResponse},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.WaitForDashboard(ctx, "cIBgcSjkk", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid := resp.Model["uid"]; uid != "cIBgcSjkk" {
+		t.Errorf("Invalid uid - %s, Expected %s", uid, "cIBgcSjkk")
+	}
+
+	client = gapiTestTools(t, 403, `{"message":"Access denied"}`)
+	if _, err = client.WaitForDashboard(ctx, "cIBgcSjkk", time.Millisecond); err == nil {
+		t.Error("403 not detected")
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{404, `{"message":"Dashboard not found"}`},
+		{404, `{"message":"Dashboard not found"}`},
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), 15*time.Millisecond)
+	defer cancel()
+	if _, err = client.WaitForDashboard(ctx, "cIBgcSjkk", 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	client = gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, getDashboardResponse)
+	}))
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err = client.WaitForDashboard(ctx, "cIBgcSjkk", time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if time.Since(start) > 150*time.Millisecond {
+		t.Errorf("Expected a hung request to be cancelled at the deadline, waited %s", time.Since(start))
+	}
+}
+
+func TestDashboardsModifiedSince(t *testing.T) {
+	responses := map[string]string{
+		"/api/search":             `[{"id": 1, "uid": "old", "type": "dash-db"}, {"id": 2, "uid": "new", "type": "dash-db"}]`,
+		"/api/dashboards/uid/old": `{"dashboard": {"uid": "old"}, "meta": {"updated": "2023-01-01T00:00:00Z"}}`,
+		"/api/dashboards/uid/new": `{"dashboard": {"uid": "new"}, "meta": {"updated": "2023-06-01T12:00:00Z"}}`,
+	}
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+
+	dashboards, err := client.DashboardsModifiedSince(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if len(dashboards) != 1 || dashboards[0].UID != "new" {
+		t.Errorf("expected dashboard new; got %v", dashboards)
+	}
+}
+
+func TestResolveDashboardInputs(t *testing.T) {
+	model := map[string]interface{}{
+		"__inputs": []interface{}{
+			map[string]interface{}{"name": "DS_PROMETHEUS", "type": "datasource", "pluginId": "prometheus"},
+			map[string]interface{}{"name": "VAR_JOB", "type": "constant", "value": "node"},
+		},
+	}
+
+	client := gapiTestTools(t, 200, `[
+		{"id": 1, "uid": "prom", "name": "Prometheus", "type": "prometheus"},
+		{"id": 2, "uid": "loki", "name": "Loki", "type": "loki"}
+	]`)
+	inputs, err := client.ResolveDashboardInputs(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(inputs))
+
+	expected := DashboardImportInput{Name: "DS_PROMETHEUS", PluginId: "prometheus", Type: "datasource", Value: "prom"}
+	if len(inputs) != 1 || inputs[0] != expected {
+		t.Errorf("expected %v; got %v", expected, inputs)
+	}
+
+	client = gapiTestTools(t, 200, `[
+		{"id": 1, "uid": "prom-a", "name": "Prometheus A", "type": "prometheus"},
+		{"id": 2, "uid": "prom-b", "name": "Prometheus B", "type": "prometheus"}
+	]`)
+	_, err = client.ResolveDashboardInputs(model)
+	if err == nil || !strings.Contains(err.Error(), "Prometheus A (prom-a), Prometheus B (prom-b)") {
+		t.Errorf("expected an error listing the data sources; got %v", err)
+	}
+}
+
+func TestDashboardsByUIDsConcurrent(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+	)
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		uid := strings.TrimPrefix(r.URL.Path, "/api/dashboards/uid/")
+		if uid == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Dashboard not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"dashboard": {"uid": %q, "title": "Dashboard %s"}, "meta": {"slug": %q}}`, uid, uid, uid)
+	}))
+
+	dashboards, errs := client.DashboardsByUIDsConcurrent([]string{"a", "b", "missing", "c", "d"}, 2)
+
+	if len(dashboards) != 4 || dashboards["c"].Model["title"] != "Dashboard c" {
+		t.Errorf("Expected 4 dashboards, got %v", dashboards)
+	}
+	if len(errs) != 1 || errs["missing"] == nil {
+		t.Errorf("Expected an error for the missing dashboard, got %v", errs)
+	}
+	if maxSeen > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxSeen)
+	}
+}
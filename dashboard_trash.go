@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// DeletedDashboards fetches and returns the dashboards in the trash, from which they can be restored.
+// It requires Grafana 11.
+func (c *Client) DeletedDashboards() ([]FolderDashboardSearchResponse, error) {
+	if err := c.requireMajorVersion(11, "deleted dashboards"); err != nil {
+		return nil, err
+	}
+
+	query := make(url.Values)
+	query.Set("type", "dash-db")
+	query.Set("deleted", "true")
+
+	return c.folderDashboardSearchAll(query)
+}
+
+// RestoreDeletedDashboard restores the dashboard in the trash whose UID it's passed. It requires Grafana 11.
+func (c *Client) RestoreDeletedDashboard(uid string) error {
+	if err := c.requireMajorVersion(11, "deleted dashboards"); err != nil {
+		return err
+	}
+
+	return c.request("PATCH", fmt.Sprintf("/api/dashboards/uid/%s/trash", uid), nil, nil, nil)
+}
+
+// HardDeleteDashboard permanently deletes the dashboard in the trash whose UID it's passed. It requires Grafana 11.
+func (c *Client) HardDeleteDashboard(uid string) error {
+	if err := c.requireMajorVersion(11, "deleted dashboards"); err != nil {
+		return err
+	}
+
+	return c.request("DELETE", fmt.Sprintf("/api/dashboards/uid/%s/trash", uid), nil, nil, nil)
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	grafana11HealthJSON     = `{"commit": "83b9528bce", "database": "ok", "version": "11.3.0"}`
+	deletedDashboardsJSON   = `[{"id": 1, "uid": "cIBgcSjkk", "title": "Production Overview", "type": "dash-db"}]`
+	restoreDashboardJSON    = `{"message":"Dashboard restored"}`
+	hardDeleteDashboardJSON = `{"message":"Dashboard permanently deleted"}`
+)
+
+func TestDeletedDashboards(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, grafana11HealthJSON},
+		{200, frontendSettingsJSON},
+		{200, deletedDashboardsJSON},
+		{200, restoreDashboardJSON},
+		{200, hardDeleteDashboardJSON},
+	})
+
+	dashboards, err := client.DeletedDashboards()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if len(dashboards) != 1 || dashboards[0].UID != "cIBgcSjkk" {
+		t.Errorf("Not correctly parsing returned deleted dashboards: %v", dashboards)
+	}
+
+	if err := client.RestoreDeletedDashboard("cIBgcSjkk"); err != nil {
+		t.Error(err)
+	}
+	if err := client.HardDeleteDashboard("cIBgcSjkk"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeletedDashboards_unsupportedVersion(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+	})
+
+	_, err := client.DeletedDashboards()
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected unsupported version error, got %v", err)
+	}
+}
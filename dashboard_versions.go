@@ -0,0 +1,108 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ErrVersionPruningUnsupported is returned by PruneDashboardVersions when versions would have to be deleted,
+// which Grafana's API doesn't allow.
+var ErrVersionPruningUnsupported = errors.New("deleting dashboard versions is not supported by the Grafana API")
+
+// DashboardVersion represents a version in the history of a dashboard.
+type DashboardVersion struct {
+	ID            int64       `json:"id"`
+	DashboardID   int64       `json:"dashboardId"`
+	DashboardUID  string      `json:"dashboardUid"`
+	ParentVersion int64       `json:"parentVersion"`
+	RestoredFrom  int64       `json:"restoredFrom"`
+	Version       int64       `json:"version"`
+	Created       GrafanaTime `json:"created"`
+	CreatedBy     string      `json:"createdBy"`
+	Message       string      `json:"message"`
+}
+
+const dashboardVersionsPerPage = 1000
+
+// DashboardVersions fetches and returns the version history of the dashboard whose UID it's passed,
+// most recent first.
+func (c *Client) DashboardVersions(uid string) ([]DashboardVersion, error) {
+	params := make(url.Values)
+	params.Set("limit", strconv.Itoa(dashboardVersionsPerPage))
+
+	versions := make([]DashboardVersion, 0)
+	for {
+		var raw json.RawMessage
+		if err := c.request("GET", fmt.Sprintf("/api/dashboards/uid/%s/versions", uid), params, nil, &raw); err != nil {
+			return nil, err
+		}
+
+		// Grafana versions before 11 respond with a plain list, paged by offset, rather than an envelope paged by token.
+		if len(raw) > 0 && raw[0] == '[' {
+			var page []DashboardVersion
+			if err := json.Unmarshal(raw, &page); err != nil {
+				return nil, err
+			}
+			versions = append(versions, page...)
+			if len(page) < dashboardVersionsPerPage {
+				return versions, nil
+			}
+			params.Set("start", strconv.Itoa(len(versions)))
+			continue
+		}
+
+		var page struct {
+			ContinueToken string             `json:"continueToken"`
+			Versions      []DashboardVersion `json:"versions"`
+		}
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return nil, err
+		}
+		versions = append(versions, page.Versions...)
+		if page.ContinueToken == "" || len(page.Versions) == 0 {
+			return versions, nil
+		}
+		params.Set("continueToken", page.ContinueToken)
+	}
+}
+
+// PrunableDashboardVersions returns the versions of the dashboard whose UID it's passed which are older than
+// its keep most recent ones, most recent first. Grafana has no API to delete dashboard versions: it deletes
+// old versions itself, keeping the number set by the versions_to_keep option of its [dashboards]
+// configuration section (20 by default), which this helps choose.
+func (c *Client) PrunableDashboardVersions(uid string, keep int) ([]DashboardVersion, error) {
+	if keep < 1 {
+		return nil, fmt.Errorf("at least one dashboard version must be kept, got %d", keep)
+	}
+
+	versions, err := c.DashboardVersions(uid)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) <= keep {
+		return []DashboardVersion{}, nil
+	}
+
+	return versions[keep:], nil
+}
+
+// PruneDashboardVersions is meant to delete all but the keep most recent versions of the dashboard whose UID
+// it's passed, and return how many were deleted. However, no Grafana version exposes an API to delete dashboard
+// versions: Grafana deletes old versions itself, keeping the number set by the versions_to_keep option of its
+// [dashboards] configuration section (20 by default). So it only succeeds, with nothing deleted, if there are
+// no more than keep versions, and otherwise returns an error wrapping ErrVersionPruningUnsupported saying how
+// many versions are in excess. PrunableDashboardVersions lists them.
+func (c *Client) PruneDashboardVersions(uid string, keep int) (deleted int, err error) {
+	prunable, err := c.PrunableDashboardVersions(uid, keep)
+	if err != nil {
+		return 0, err
+	}
+	if len(prunable) > 0 {
+		return 0, fmt.Errorf("%w: dashboard %s has %d versions more than %d, lower versions_to_keep in the Grafana configuration instead", ErrVersionPruningUnsupported, uid, len(prunable), keep)
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gobs/pretty"
+)
+
+const getDashboardVersionsJSON = `{
+	"continueToken": "",
+	"versions": [
+		{"id": 3, "dashboardId": 1, "dashboardUid": "cIBgcSjkk", "parentVersion": 2, "version": 3, "created": "2023-06-13T15:04:05Z", "createdBy": "admin", "message": "Fixed thresholds"},
+		{"id": 2, "dashboardId": 1, "dashboardUid": "cIBgcSjkk", "parentVersion": 1, "version": 2, "created": "2023-06-12T15:04:05Z", "createdBy": "admin", "message": ""},
+		{"id": 1, "dashboardId": 1, "dashboardUid": "cIBgcSjkk", "version": 1, "created": "2023-06-11T15:04:05Z", "createdBy": "admin", "message": ""}
+	]
+}`
+
+func TestDashboardVersions(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"continueToken": "next", "versions": [{"id": 4, "version": 4, "created": "2023-06-14T15:04:05Z"}]}`},
+		{200, getDashboardVersionsJSON},
+	})
+
+	versions, err := client.DashboardVersions("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(versions))
+
+	if len(versions) != 4 || versions[0].Version != 4 || versions[1].Message != "Fixed thresholds" {
+		t.Errorf("Not correctly parsing returned versions - %v", versions)
+	}
+	if !versions[1].Created.Time().Equal(time.Date(2023, 6, 13, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("Not correctly parsing version creation time - %v", versions[1].Created)
+	}
+
+	client = gapiTestTools(t, 200, `[{"id": 1, "version": 1, "created": "2023-06-11T15:04:05Z"}]`)
+	versions, err = client.DashboardVersions("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].Version != 1 {
+		t.Errorf("Not correctly parsing returned version list - %v", versions)
+	}
+}
+
+func TestPrunableDashboardVersions(t *testing.T) {
+	client := gapiTestTools(t, 200, getDashboardVersionsJSON)
+	versions, err := client.PrunableDashboardVersions("cIBgcSjkk", 3)
+	if err != nil || len(versions) != 0 {
+		t.Errorf("expected nothing to prune; got %v, %v", versions, err)
+	}
+
+	client = gapiTestTools(t, 200, getDashboardVersionsJSON)
+	versions, err = client.PrunableDashboardVersions("cIBgcSjkk", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 1 {
+		t.Errorf("expected the two oldest versions to be prunable; got %v", versions)
+	}
+
+	if _, err := client.PrunableDashboardVersions("cIBgcSjkk", 0); err == nil {
+		t.Error("expected an error when keeping no version")
+	}
+}
+
+func TestPruneDashboardVersions(t *testing.T) {
+	client := gapiTestTools(t, 200, getDashboardVersionsJSON)
+	deleted, err := client.PruneDashboardVersions("cIBgcSjkk", 3)
+	if err != nil || deleted != 0 {
+		t.Errorf("expected nothing to prune; got %d, %v", deleted, err)
+	}
+
+	client = gapiTestTools(t, 200, getDashboardVersionsJSON)
+	if _, err := client.PruneDashboardVersions("cIBgcSjkk", 1); !errors.Is(err, ErrVersionPruningUnsupported) {
+		t.Errorf("expected unsupported pruning error; got %v", err)
+	}
+}
@@ -3,7 +3,10 @@ package gapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // DataSource represents a Grafana data source.
@@ -36,7 +39,8 @@ type DataSource struct {
 }
 
 // NewDataSource creates a new Grafana data source.
-func (c *Client) NewDataSource(s *DataSource) (int64, error) {
+// Optional query parameters are sent along with the request.
+func (c *Client) NewDataSource(s *DataSource, optionalQueryParams ...url.Values) (int64, error) {
 	data, err := json.Marshal(s)
 	if err != nil {
 		return 0, err
@@ -46,7 +50,7 @@ func (c *Client) NewDataSource(s *DataSource) (int64, error) {
 		ID int64 `json:"id"`
 	}{}
 
-	err = c.request("POST", "/api/datasources", nil, bytes.NewBuffer(data), &result)
+	err = c.request("POST", "/api/datasources", mergeQueryParams(optionalQueryParams), bytes.NewBuffer(data), &result)
 	if err != nil {
 		return 0, err
 	}
@@ -55,24 +59,25 @@ func (c *Client) NewDataSource(s *DataSource) (int64, error) {
 }
 
 // UpdateDataSource updates a Grafana data source.
-func (c *Client) UpdateDataSource(s *DataSource) error {
+// Optional query parameters are sent along with the request.
+func (c *Client) UpdateDataSource(s *DataSource, optionalQueryParams ...url.Values) error {
 	path := fmt.Sprintf("/api/datasources/%d", s.ID)
 	data, err := json.Marshal(s)
 	if err != nil {
 		return err
 	}
 
-	return c.request("PUT", path, nil, bytes.NewBuffer(data), nil)
+	return c.request("PUT", path, mergeQueryParams(optionalQueryParams), bytes.NewBuffer(data), nil)
 }
 
-func (c *Client) UpdateDataSourceByUID(s *DataSource) error {
+func (c *Client) UpdateDataSourceByUID(s *DataSource, optionalQueryParams ...url.Values) error {
 	path := fmt.Sprintf("/api/datasources/uid/%s", s.UID)
 	data, err := json.Marshal(s)
 	if err != nil {
 		return err
 	}
 
-	return c.request("PUT", path, nil, bytes.NewBuffer(data), nil)
+	return c.request("PUT", path, mergeQueryParams(optionalQueryParams), bytes.NewBuffer(data), nil)
 }
 
 // DataSource fetches and returns the Grafana data source whose ID it's passed.
@@ -99,9 +104,21 @@ func (c *Client) DataSourceByUID(uid string) (*DataSource, error) {
 	return result, err
 }
 
+// DataSourceByName fetches and returns the Grafana data source whose name is passed.
+func (c *Client) DataSourceByName(name string) (*DataSource, error) {
+	path := fmt.Sprintf("/api/datasources/name/%s", url.PathEscape(name))
+	result := &DataSource{}
+	err := c.request("GET", path, nil, nil, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, err
+}
+
 // DataSourceIDByName returns the Grafana data source ID by name.
 func (c *Client) DataSourceIDByName(name string) (int64, error) {
-	path := fmt.Sprintf("/api/datasources/id/%s", name)
+	path := fmt.Sprintf("/api/datasources/id/%s", url.PathEscape(name))
 
 	result := struct {
 		ID int64 `json:"id"`
@@ -127,15 +144,34 @@ func (c *Client) DataSources() ([]*DataSource, error) {
 }
 
 // DeleteDataSource deletes the Grafana data source whose ID it's passed.
+// A data source which doesn't exist is considered already deleted, making teardowns idempotent.
 func (c *Client) DeleteDataSource(id int64) error {
 	path := fmt.Sprintf("/api/datasources/%d", id)
 
-	return c.request("DELETE", path, nil, nil, nil)
+	return ignoreNotFound(c.request("DELETE", path, nil, nil, nil))
 }
 
 // DeleteDataSourceByName deletes the Grafana data source whose NAME it's passed.
+// A data source which doesn't exist is considered already deleted, making teardowns idempotent.
 func (c *Client) DeleteDataSourceByName(name string) error {
-	path := fmt.Sprintf("/api/datasources/name/%s", name)
+	path := fmt.Sprintf("/api/datasources/name/%s", url.PathEscape(name))
+
+	return ignoreNotFound(c.request("DELETE", path, nil, nil, nil))
+}
+
+// DeleteDataSourceByUID deletes the Grafana data source whose UID it's passed.
+// A data source which doesn't exist is considered already deleted, making teardowns idempotent.
+func (c *Client) DeleteDataSourceByUID(uid string) error {
+	path := fmt.Sprintf("/api/datasources/uid/%s", url.PathEscape(uid))
 
-	return c.request("DELETE", path, nil, nil, nil)
+	return ignoreNotFound(c.request("DELETE", path, nil, nil, nil))
+}
+
+// ignoreNotFound returns nil if err is a 404 Not Found APIError, and err otherwise.
+func ignoreNotFound(err error) error {
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
 }
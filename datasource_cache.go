@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// DataSourceCacheConfig is the query caching configuration of a data source, in Grafana Enterprise.
+type DataSourceCacheConfig struct {
+	DataSourceID  int64  `json:"dataSourceID,omitempty"`
+	DataSourceUID string `json:"dataSourceUID,omitempty"`
+	Enabled       bool   `json:"enabled"`
+	// UseDefaultTTL makes the data source use the server's default TTL, DefaultTTLMs, instead of
+	// TTLQueriesMs and TTLResourcesMs.
+	UseDefaultTTL bool `json:"useDefaultTTL"`
+	// TTLQueriesMs and TTLResourcesMs are how long query and resource responses are cached, in milliseconds.
+	TTLQueriesMs   int64 `json:"ttlQueriesMs"`
+	TTLResourcesMs int64 `json:"ttlResourcesMs"`
+	DefaultTTLMs   int64 `json:"defaultTTLMs,omitempty"`
+}
+
+// DataSourceCache fetches and returns the query caching configuration of the data source whose UID it's passed.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) DataSourceCache(uid string) (*DataSourceCacheConfig, error) {
+	if err := c.requireEnterprise("query caching"); err != nil {
+		return nil, err
+	}
+
+	config := &DataSourceCacheConfig{}
+	err := c.request("GET", fmt.Sprintf("/api/datasources/%s/cache", url.PathEscape(uid)), nil, nil, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// UpdateDataSourceCache updates the query caching configuration of the data source whose UID it's passed.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) UpdateDataSourceCache(uid string, config DataSourceCacheConfig) error {
+	if err := c.requireEnterprise("query caching"); err != nil {
+		return err
+	}
+
+	config.DataSourceUID = uid
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return c.request("POST", fmt.Sprintf("/api/datasources/%s/cache", url.PathEscape(uid)), nil, bytes.NewBuffer(data), nil)
+}
+
+// SetDefaultDataSourceCacheTTL enables query caching for the data source whose UID it's passed, caching both
+// query and resource responses for ttl, which is rounded down to the millisecond.
+func (c *Client) SetDefaultDataSourceCacheTTL(uid string, ttl time.Duration) error {
+	ms := ttl.Milliseconds()
+	if ms <= 0 {
+		return fmt.Errorf("cache TTL must be at least a millisecond, got %s", ttl)
+	}
+
+	return c.UpdateDataSourceCache(uid, DataSourceCacheConfig{
+		Enabled:        true,
+		TTLQueriesMs:   ms,
+		TTLResourcesMs: ms,
+	})
+}
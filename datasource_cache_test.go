@@ -0,0 +1,86 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gobs/pretty"
+)
+
+const getDataSourceCacheJSON = `{
+	"dataSourceID": 1,
+	"dataSourceUID": "prom",
+	"enabled": true,
+	"useDefaultTTL": false,
+	"ttlQueriesMs": 300000,
+	"ttlResourcesMs": 60000,
+	"defaultTTLMs": 300000
+}`
+
+func TestDataSourceCache(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, getDataSourceCacheJSON},
+	})
+
+	config, err := client.DataSourceCache("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(config))
+
+	if !config.Enabled || config.TTLQueriesMs != 300000 || config.TTLResourcesMs != 60000 {
+		t.Errorf("Not correctly parsing returned cache config: %v", config)
+	}
+}
+
+func TestSetDefaultDataSourceCacheTTL(t *testing.T) {
+	var config DataSourceCacheConfig
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/health":
+			fmt.Fprint(w, healthOKJSON)
+		case "/api/frontend/settings":
+			fmt.Fprint(w, frontendSettingsJSON)
+		case "/api/datasources/prom/cache":
+			if r.Method != "POST" {
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				t.Error(err)
+			}
+			fmt.Fprint(w, getDataSourceCacheJSON)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	if err := client.SetDefaultDataSourceCacheTTL("prom", 5*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	expected := DataSourceCacheConfig{DataSourceUID: "prom", Enabled: true, TTLQueriesMs: 300000, TTLResourcesMs: 300000}
+	if config != expected {
+		t.Errorf("expected %v; got %v", expected, config)
+	}
+
+	if err := client.SetDefaultDataSourceCacheTTL("prom", 0); err == nil {
+		t.Error("expected an error for a zero TTL")
+	}
+}
+
+func TestDataSourceCache_OSS(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, `{"buildInfo": {"version": "10.0.0", "edition": "Open Source"}}`},
+	})
+
+	if _, err := client.DataSourceCache("prom"); !errors.Is(err, ErrEnterpriseRequired) {
+		t.Errorf("Expected Enterprise required error, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DataSourceHealth represents the result of a data source health check.
+type DataSourceHealth struct {
+	// Status is OK when Grafana could connect to and query the data source, and ERROR otherwise.
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// OK reports whether the health check succeeded.
+func (h DataSourceHealth) OK() bool {
+	return h.Status == "OK"
+}
+
+// DataSourceConnection describes the configuration and connectivity of a data source.
+// Each check is run independently, so a failed check leaves its fields empty and sets its error.
+type DataSourceConnection struct {
+	DataSource  *DataSource
+	ConfigError error
+
+	Health      *DataSourceHealth
+	HealthError error
+	// Reachable reports whether the health check succeeded.
+	Reachable bool
+	// TLSIssue is the health check message when it failed because of TLS, e.g. an untrusted certificate.
+	TLSIssue string
+
+	// Version is the version of the data source server, for Prometheus and Loki data sources.
+	Version      string
+	VersionError error
+}
+
+// DataSourceHealth runs the health check of the data source whose UID it's passed. A failed check is
+// returned as a DataSourceHealth with an ERROR status, errors are only returned if the check couldn't run.
+func (c *Client) DataSourceHealth(uid string) (*DataSourceHealth, error) {
+	health := &DataSourceHealth{}
+	err := c.request("GET", fmt.Sprintf("/api/datasources/uid/%s/health", uid), nil, nil, health)
+
+	// Grafana responds to failed checks with a 400 holding the check result.
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		if status, ok := apiErr.Body["status"].(string); ok {
+			message, _ := apiErr.Body["message"].(string)
+			return &DataSourceHealth{Status: status, Message: message}, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+// DataSourceConnectionDetails reads the configuration of the data source whose UID it's passed, runs its
+// health check and, for Prometheus and Loki data sources, fetches the server version through the data source
+// proxy. Checks which fail set their error in the returned DataSourceConnection rather than failing the call.
+func (c *Client) DataSourceConnectionDetails(uid string) (*DataSourceConnection, error) {
+	conn := &DataSourceConnection{}
+	conn.DataSource, conn.ConfigError = c.DataSourceByUID(uid)
+
+	conn.Health, conn.HealthError = c.DataSourceHealth(uid)
+	if conn.Health != nil {
+		conn.Reachable = conn.Health.OK()
+		if !conn.Reachable && isTLSMessage(conn.Health.Message) {
+			conn.TLSIssue = conn.Health.Message
+		}
+	}
+
+	if conn.DataSource != nil {
+		conn.Version, conn.VersionError = c.dataSourceVersion(conn.DataSource)
+	}
+
+	return conn, nil
+}
+
+// dataSourceVersion returns the server version of Prometheus and Loki data sources, and an empty version for
+// other types.
+func (c *Client) dataSourceVersion(ds *DataSource) (string, error) {
+	switch ds.Type {
+	case "prometheus":
+		info, err := prometheusProxy[dataSourceBuildInfo](c, ds.UID, "api/v1/status/buildinfo")
+		return info.Version, err
+	case "loki":
+		// Loki serves its build info without the Prometheus response envelope.
+		var info dataSourceBuildInfo
+		err := c.DataSourceProxy("GET", ds.UID, "loki/api/v1/status/buildinfo", nil, nil, &info)
+		return info.Version, err
+	}
+	return "", nil
+}
+
+type dataSourceBuildInfo struct {
+	Version string `json:"version"`
+}
+
+// isTLSMessage reports whether an error message is about TLS, such as certificate verification errors.
+func isTLSMessage(message string) bool {
+	message = strings.ToLower(message)
+	for _, s := range []string{"x509", "tls", "certificate"} {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+func TestDataSourceHealth(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"status": "OK", "message": "Data source is working"}`)
+
+	health, err := client.DataSourceHealth("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !health.OK() || health.Message != "Data source is working" {
+		t.Errorf("Not correctly parsing returned health - %v", health)
+	}
+
+	client = gapiTestTools(t, 400, `{"status": "ERROR", "message": "Post \"https://prom\": x509: certificate signed by unknown authority"}`)
+	health, err = client.DataSourceHealth("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.OK() || !strings.Contains(health.Message, "x509") {
+		t.Errorf("Expected failed health check, got %v", health)
+	}
+}
+
+func TestDataSourceConnectionDetails(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/datasources/uid/prom":
+			fmt.Fprint(w, `{"uid": "prom", "name": "Prometheus", "type": "prometheus"}`)
+		case "/api/datasources/uid/prom/health":
+			fmt.Fprint(w, `{"status": "OK", "message": "Successfully queried the Prometheus API."}`)
+		case "/api/datasources/proxy/uid/prom/api/v1/status/buildinfo":
+			fmt.Fprint(w, `{"status": "success", "data": {"version": "2.45.0", "revision": "abc"}}`)
+		case "/api/datasources/uid/loki":
+			fmt.Fprint(w, `{"uid": "loki", "name": "Loki", "type": "loki"}`)
+		case "/api/datasources/uid/loki/health":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status": "ERROR", "message": "tls: failed to verify certificate"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Not found"}`)
+		}
+	}))
+
+	conn, err := client.DataSourceConnectionDetails("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(conn))
+
+	if conn.DataSource.Name != "Prometheus" || !conn.Reachable || conn.Version != "2.45.0" || conn.VersionError != nil {
+		t.Errorf("Unexpected connection details - %v", conn)
+	}
+
+	conn, err = client.DataSourceConnectionDetails("loki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.Reachable || conn.TLSIssue == "" {
+		t.Errorf("Expected TLS issue, got %v", conn)
+	}
+	if conn.Version != "" || conn.VersionError == nil {
+		t.Errorf("Expected version error, got %v", conn)
+	}
+
+	conn, err = client.DataSourceConnectionDetails("missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.DataSource != nil || conn.ConfigError == nil || conn.HealthError == nil {
+		t.Errorf("Expected config and health errors, got %v", conn)
+	}
+}
@@ -122,16 +122,7 @@ type JSONData struct {
 
 // Marshal JSONData
 func (d JSONData) Map() (map[string]interface{}, error) {
-	b, err := json.Marshal(d)
-	if err != nil {
-		return nil, err
-	}
-	fields := make(map[string]interface{})
-	if err = json.Unmarshal(b, &fields); err != nil {
-		return nil, err
-	}
-
-	return fields, nil
+	return structToMap(d)
 }
 
 // SecureJSONData is a representation of the datasource `secureJsonData` property
@@ -165,7 +156,94 @@ type SecureJSONData struct {
 }
 
 func (d SecureJSONData) Map() (map[string]interface{}, error) {
-	b, err := json.Marshal(d)
+	return structToMap(d)
+}
+
+// PrometheusJSONData is the `jsonData` of a Prometheus datasource.
+// Unlike JSONData, it only holds the settings of Prometheus and leaves unset fields out of its map.
+type PrometheusJSONData struct {
+	HTTPMethod   string `json:"httpMethod,omitempty"`
+	TimeInterval string `json:"timeInterval,omitempty"`
+	QueryTimeout string `json:"queryTimeout,omitempty"`
+
+	// PrometheusType is the flavor of the server, e.g. Prometheus, Cortex, Mimir or Thanos.
+	PrometheusType    string `json:"prometheusType,omitempty"`
+	PrometheusVersion string `json:"prometheusVersion,omitempty"`
+	CacheLevel        string `json:"cacheLevel,omitempty"`
+
+	CustomQueryParameters string `json:"customQueryParameters,omitempty"`
+	DisableMetricsLookup  bool   `json:"disableMetricsLookup,omitempty"`
+	IncrementalQuerying   bool   `json:"incrementalQuerying,omitempty"`
+
+	ExemplarTraceIDDestinations []PrometheusExemplarTraceIDDestination `json:"exemplarTraceIdDestinations,omitempty"`
+
+	// ManageAlerts defaults to true in Grafana when unset.
+	ManageAlerts    *bool  `json:"manageAlerts,omitempty"`
+	AlertmanagerUID string `json:"alertmanagerUid,omitempty"`
+}
+
+// PrometheusExemplarTraceIDDestination links the trace IDs of Prometheus exemplars to a tracing datasource or URL.
+type PrometheusExemplarTraceIDDestination struct {
+	Name          string `json:"name"`
+	DatasourceUID string `json:"datasourceUid,omitempty"`
+	URL           string `json:"url,omitempty"`
+}
+
+// Map returns the datasource `jsonData` property.
+func (d PrometheusJSONData) Map() (map[string]interface{}, error) {
+	return structToMap(d)
+}
+
+// LokiJSONData is the `jsonData` of a Loki datasource.
+type LokiJSONData struct {
+	MaxLines      int                `json:"maxLines,omitempty"`
+	DerivedFields []LokiDerivedField `json:"derivedFields,omitempty"`
+	Timeout       int64              `json:"timeout,omitempty"`
+
+	// ManageAlerts defaults to true in Grafana when unset.
+	ManageAlerts    *bool  `json:"manageAlerts,omitempty"`
+	AlertmanagerUID string `json:"alertmanagerUid,omitempty"`
+}
+
+// Map returns the datasource `jsonData` property.
+func (d LokiJSONData) Map() (map[string]interface{}, error) {
+	return structToMap(d)
+}
+
+// CloudWatchJSONData is the `jsonData` of a CloudWatch datasource.
+type CloudWatchJSONData struct {
+	// AuthType is one of default, keys, credentials (a shared credentials file profile) or ec2_iam_role.
+	AuthType      string `json:"authType,omitempty"`
+	DefaultRegion string `json:"defaultRegion,omitempty"`
+	AssumeRoleArn string `json:"assumeRoleArn,omitempty"`
+	ExternalID    string `json:"externalId,omitempty"`
+	Endpoint      string `json:"endpoint,omitempty"`
+	Profile       string `json:"profile,omitempty"`
+
+	CustomMetricsNamespaces string `json:"customMetricsNamespaces,omitempty"`
+	LogsTimeout             string `json:"logsTimeout,omitempty"`
+	TracingDatasourceUID    string `json:"tracingDatasourceUid,omitempty"`
+}
+
+// Map returns the datasource `jsonData` property.
+func (d CloudWatchJSONData) Map() (map[string]interface{}, error) {
+	return structToMap(d)
+}
+
+// CloudWatchSecureJSONData is the `secureJsonData` of a CloudWatch datasource using the keys auth type.
+type CloudWatchSecureJSONData struct {
+	AccessKey string `json:"accessKey,omitempty"`
+	SecretKey string `json:"secretKey,omitempty"`
+}
+
+// Map returns the datasource `secureJsonData` property.
+func (d CloudWatchSecureJSONData) Map() (map[string]interface{}, error) {
+	return structToMap(d)
+}
+
+// structToMap converts a struct into the map of its JSON fields.
+func structToMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TeamLBACRule represents the label based access control rules of a team on a data source.
+// Rules are LogQL/PromQL label matchers, e.g. `{namespace="payments"}`.
+type TeamLBACRule struct {
+	TeamID  int64    `json:"teamId,omitempty"`
+	TeamUID string   `json:"teamUid"`
+	Rules   []string `json:"rules"`
+}
+
+// TeamLBACRules represents the team label based access control rules of a data source.
+type TeamLBACRules struct {
+	Rules []TeamLBACRule `json:"rules"`
+}
+
+// GetTeamLBACRules fetches the team label based access control rules of the data source whose UID it's passed.
+// These rules are only available in Grafana Cloud; elsewhere an error mentioning it is returned.
+func (c *Client) GetTeamLBACRules(datasourceUID string) ([]TeamLBACRule, error) {
+	path := fmt.Sprintf("/api/datasources/uid/%s/lbac/teams", datasourceUID)
+	result := TeamLBACRules{}
+	if err := c.request("GET", path, nil, nil, &result); err != nil {
+		return nil, lbacError(datasourceUID, err)
+	}
+
+	return result.Rules, nil
+}
+
+// SetTeamLBACRules replaces the team label based access control rules of the data source whose UID it's passed.
+// These rules are only available in Grafana Cloud; elsewhere an error mentioning it is returned.
+func (c *Client) SetTeamLBACRules(datasourceUID string, rules []TeamLBACRule) error {
+	path := fmt.Sprintf("/api/datasources/uid/%s/lbac/teams", datasourceUID)
+	data, err := json.Marshal(TeamLBACRules{Rules: rules})
+	if err != nil {
+		return err
+	}
+
+	if err := c.request("PUT", path, nil, bytes.NewBuffer(data), nil); err != nil {
+		return lbacError(datasourceUID, err)
+	}
+	return nil
+}
+
+func lbacError(datasourceUID string, err error) error {
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("team LBAC rules not found for data source %s, they are only supported in Grafana Cloud: %w", datasourceUID, err)
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const getTeamLBACRulesJSON = `{
+	"rules": [
+		{
+			"teamId": 1,
+			"teamUid": "fdz2xbrb1ix4wa",
+			"rules": ["{namespace=\"payments\"}", "{cluster=\"prod\"}"]
+		}
+	]
+}`
+
+func TestGetTeamLBACRules(t *testing.T) {
+	client := gapiTestTools(t, 200, getTeamLBACRulesJSON)
+
+	rules, err := client.GetTeamLBACRules("loki")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(rules))
+
+	if len(rules) != 1 || rules[0].TeamUID != "fdz2xbrb1ix4wa" || len(rules[0].Rules) != 2 {
+		t.Errorf("Not correctly parsing returned rules: %v", rules)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Not found"}`)
+	_, err = client.GetTeamLBACRules("loki")
+	if err == nil || !strings.Contains(err.Error(), "Grafana Cloud") {
+		t.Errorf("Expected not supported error, got %v", err)
+	}
+}
+
+func TestSetTeamLBACRules(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"message":"Data source LBAC rules updated"}`)
+
+	err := client.SetTeamLBACRules("loki", []TeamLBACRule{
+		{TeamUID: "fdz2xbrb1ix4wa", Rules: []string{`{namespace="payments"}`}},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	for _, code := range []int{400, 403, 404} {
+		client = gapiTestTools(t, code, "error")
+		if err = client.SetTeamLBACRules("loki", nil); err == nil {
+			t.Errorf("%d not detected", code)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package gapi
+
+import "net/url"
+
+// DataSourcePluginType represents a data source plugin installed on the Grafana server.
+// Its ID is the type of the data sources it implements, e.g. prometheus.
+type DataSourcePluginType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+// DataSourcePluginTypes fetches and returns the data source plugins installed on the Grafana server,
+// whose IDs are the valid data source types.
+func (c *Client) DataSourcePluginTypes() ([]DataSourcePluginType, error) {
+	query := make(url.Values)
+	query.Set("type", "datasource")
+
+	plugins := make([]DataSourcePluginType, 0)
+	if err := c.request("GET", "/api/plugins", query, nil, &plugins); err != nil {
+		return nil, err
+	}
+
+	return plugins, nil
+}
@@ -0,0 +1,34 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+func TestDataSourcePluginTypes(t *testing.T) {
+	var pluginType string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pluginType = r.URL.Query().Get("type")
+		fmt.Fprint(w, `[
+			{"name": "Prometheus", "type": "datasource", "id": "prometheus", "enabled": true, "pinned": false},
+			{"name": "Loki", "type": "datasource", "id": "loki", "enabled": true, "pinned": false}
+		]`)
+	}))
+
+	plugins, err := client.DataSourcePluginTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(plugins))
+
+	if pluginType != "datasource" {
+		t.Errorf("Expected data source plugins to be requested, got type %q", pluginType)
+	}
+	if len(plugins) != 2 || plugins[0].ID != "prometheus" || plugins[0].Name != "Prometheus" || !plugins[0].Enabled {
+		t.Error("Not correctly parsing returned data source plugins.")
+	}
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+)
+
+// PrometheusResponse represents the envelope of a Prometheus HTTP API response.
+type PrometheusResponse[T any] struct {
+	Status    string   `json:"status"`
+	Data      T        `json:"data"`
+	ErrorType string   `json:"errorType,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+// PrometheusMetricMetadata represents the metadata of a Prometheus metric.
+type PrometheusMetricMetadata struct {
+	Type string `json:"type"`
+	Help string `json:"help"`
+	Unit string `json:"unit"`
+}
+
+// DataSourceProxy performs a request against the data source whose UID it's passed, through the Grafana data source proxy.
+// proxyPath is relative to the data source URL.
+func (c *Client) DataSourceProxy(method, uid, proxyPath string, query url.Values, body io.Reader, responseStruct interface{}) error {
+	requestPath := path.Join(fmt.Sprintf("/api/datasources/proxy/uid/%s", uid), proxyPath)
+	return c.request(method, requestPath, query, body, responseStruct)
+}
+
+// PrometheusLabelNames returns the label names known to the Prometheus data source whose UID it's passed.
+func (c *Client) PrometheusLabelNames(uid string) ([]string, error) {
+	return prometheusProxy[[]string](c, uid, "api/v1/labels")
+}
+
+// PrometheusLabelValues returns the values of a label known to the Prometheus data source whose UID it's passed.
+func (c *Client) PrometheusLabelValues(uid, label string) ([]string, error) {
+	return prometheusProxy[[]string](c, uid, fmt.Sprintf("api/v1/label/%s/values", label))
+}
+
+// PrometheusMetadata returns the metric metadata, keyed by metric name, of the Prometheus data source whose UID it's passed.
+func (c *Client) PrometheusMetadata(uid string) (map[string][]PrometheusMetricMetadata, error) {
+	return prometheusProxy[map[string][]PrometheusMetricMetadata](c, uid, "api/v1/metadata")
+}
+
+func prometheusProxy[T any](c *Client, uid, proxyPath string) (T, error) {
+	var result PrometheusResponse[T]
+	if err := c.DataSourceProxy("GET", uid, proxyPath, nil, nil, &result); err != nil {
+		return result.Data, err
+	}
+	if result.Status != "success" {
+		return result.Data, fmt.Errorf("prometheus request failed with status %s: %s: %s", result.Status, result.ErrorType, result.Error)
+	}
+
+	return result.Data, nil
+}
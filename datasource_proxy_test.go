@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	prometheusLabelNamesJSON = `{
+		"status": "success",
+		"data": ["__name__", "instance", "job"]
+	}`
+
+	prometheusLabelValuesJSON = `{
+		"status": "success",
+		"data": ["node", "prometheus"]
+	}`
+
+	prometheusMetadataJSON = `{
+		"status": "success",
+		"data": {
+			"up": [{"type": "gauge", "help": "Whether the target is up.", "unit": ""}]
+		}
+	}`
+
+	prometheusErrorJSON = `{
+		"status": "error",
+		"errorType": "bad_data",
+		"error": "invalid parameter"
+	}`
+)
+
+func TestDataSourceProxy(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"foo":"bar"}`)
+
+	result := struct {
+		Foo string `json:"foo"`
+	}{}
+	err := client.DataSourceProxy("GET", "prom", "api/v1/status/config", nil, nil, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Foo != "bar" {
+		t.Errorf("expected: bar; got: %s", result.Foo)
+	}
+}
+
+func TestPrometheusLabelNames(t *testing.T) {
+	client := gapiTestTools(t, 200, prometheusLabelNamesJSON)
+
+	names, err := client.PrometheusLabelNames("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(names))
+
+	if len(names) != 3 || names[2] != "job" {
+		t.Errorf("Not correctly parsing returned label names: %v", names)
+	}
+
+	client = gapiTestTools(t, 200, prometheusErrorJSON)
+	if _, err = client.PrometheusLabelNames("prom"); err == nil {
+		t.Error("prometheus error status not detected")
+	}
+}
+
+func TestPrometheusLabelValues(t *testing.T) {
+	client := gapiTestTools(t, 200, prometheusLabelValuesJSON)
+
+	values, err := client.PrometheusLabelValues("prom", "job")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(values) != 2 || values[0] != "node" {
+		t.Errorf("Not correctly parsing returned label values: %v", values)
+	}
+
+	for _, code := range []int{400, 403, 404} {
+		client = gapiTestTools(t, code, "error")
+		if _, err = client.PrometheusLabelValues("prom", "job"); err == nil {
+			t.Errorf("%d not detected", code)
+		}
+	}
+}
+
+func TestPrometheusMetadata(t *testing.T) {
+	client := gapiTestTools(t, 200, prometheusMetadataJSON)
+
+	metadata, err := client.PrometheusMetadata("prom")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	up, ok := metadata["up"]
+	if !ok || len(up) != 1 || up[0].Type != "gauge" {
+		t.Errorf("Not correctly parsing returned metadata: %v", metadata)
+	}
+}
@@ -0,0 +1,190 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// DSQueryRequest is a request to query data sources through Grafana, as panels do.
+type DSQueryRequest struct {
+	// Queries are the data source specific queries, such as panel targets, each with a refId and a datasource
+	// reference ({"uid": ..., "type": ...}).
+	Queries []map[string]interface{} `json:"queries"`
+	// From and To are the time range, as epoch milliseconds or relative times such as now-1h.
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// DSQueryResponse holds the results of a DSQueryRequest, by query refId.
+type DSQueryResponse struct {
+	Results map[string]DSQueryResult `json:"results"`
+}
+
+// DSQueryResult is the result of a single query. Frames are data frames, each with a schema and its data.
+type DSQueryResult struct {
+	Status int                      `json:"status,omitempty"`
+	Frames []map[string]interface{} `json:"frames"`
+	Error  string                   `json:"error,omitempty"`
+}
+
+// QueryDataSources queries data sources through Grafana. Failed queries have their error set in the response,
+// Grafana only fails the request if all of them failed.
+func (c *Client) QueryDataSources(req DSQueryRequest) (*DSQueryResponse, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DSQueryResponse{}
+	// Queries only read data, so they are sent in dry-run mode too.
+	err = c.WithReadOnlyPOST().request("POST", "/api/ds/query", nil, bytes.NewBuffer(data), resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// mixedDatasourceUID is the datasource UID of panels whose queries each have their own data source.
+const mixedDatasourceUID = "-- Mixed --"
+
+// QueryDashboardPanel runs the queries of a dashboard panel over the given time range and returns their results,
+// e.g. to check what a panel shows right now. Template variables used in the queries, as $var, ${var} or [[var]],
+// are replaced by the values given by variable name; other variables are left as is. Each query uses its own data
+// source if it has one, as in panels with mixed data sources, the panel's otherwise, and the org's default data
+// source if the panel has none either.
+func (c *Client) QueryDashboardPanel(dashboardUID string, panelID int, from, to time.Time, vars map[string]string) (*DSQueryResponse, error) {
+	dashboard, err := c.DashboardByUID(dashboardUID)
+	if err != nil {
+		return nil, err
+	}
+
+	panel := findPanel(dashboard.Model, panelID)
+	if panel == nil {
+		return nil, fmt.Errorf("panel %d not found in dashboard %s", panelID, dashboardUID)
+	}
+
+	targets, _ := panel["targets"].([]interface{})
+	req := DSQueryRequest{
+		Queries: make([]map[string]interface{}, 0, len(targets)),
+		From:    strconv.FormatInt(from.UnixMilli(), 10),
+		To:      strconv.FormatInt(to.UnixMilli(), 10),
+	}
+	for _, t := range targets {
+		target, ok := substituteVariables(t, vars).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if target["datasource"] == nil {
+			target["datasource"] = substituteVariables(panel["datasource"], vars)
+		}
+		ref, err := c.datasourceRef(target["datasource"])
+		if err != nil {
+			return nil, fmt.Errorf("panel %d query %v: %w", panelID, target["refId"], err)
+		}
+		target["datasource"] = ref
+		req.Queries = append(req.Queries, target)
+	}
+	if len(req.Queries) == 0 {
+		return nil, fmt.Errorf("panel %d of dashboard %s has no queries", panelID, dashboardUID)
+	}
+
+	return c.QueryDataSources(req)
+}
+
+// findPanel returns the panel with the given ID, looking into rows, or nil if there's none.
+func findPanel(model map[string]interface{}, panelID int) map[string]interface{} {
+	var panels []interface{}
+	if p, ok := model["panels"].([]interface{}); ok {
+		panels = append(panels, p...)
+	}
+	// Dashboards from before Grafana 5 nest their panels in rows.
+	if rows, ok := model["rows"].([]interface{}); ok {
+		panels = append(panels, rows...)
+	}
+
+	for _, p := range panels {
+		panel, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := panel["id"].(float64); ok && int(id) == panelID {
+			return panel
+		}
+		if nested := findPanel(panel, panelID); nested != nil {
+			return nested
+		}
+	}
+	return nil
+}
+
+// datasourceRef returns the {"uid": ..., "type": ...} reference to a query's data source, resolving references
+// by name used by older dashboards, and missing references, which stand for the org's default data source.
+func (c *Client) datasourceRef(datasource interface{}) (map[string]interface{}, error) {
+	switch ds := datasource.(type) {
+	case nil:
+		return c.defaultDatasourceRef()
+	case map[string]interface{}:
+		if uid, _ := ds["uid"].(string); uid != "" && uid != mixedDatasourceUID {
+			return ds, nil
+		}
+	case string:
+		resolved, err := c.DataSourceByName(ds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve data source %s: %w", ds, err)
+		}
+		return map[string]interface{}{"uid": resolved.UID, "type": resolved.Type}, nil
+	}
+	return nil, fmt.Errorf("no data source set")
+}
+
+// defaultDatasourceRef returns the reference to the org's default data source.
+func (c *Client) defaultDatasourceRef() (map[string]interface{}, error) {
+	datasources, err := c.DataSources()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the default data source: %w", err)
+	}
+	for _, ds := range datasources {
+		if ds.IsDefault {
+			return map[string]interface{}{"uid": ds.UID, "type": ds.Type}, nil
+		}
+	}
+	return nil, fmt.Errorf("no data source set, and the org has no default data source")
+}
+
+// variablePattern matches template variables, as $var, ${var} (with an optional format) or [[var]].
+var variablePattern = regexp.MustCompile(`\$(\w+)|\$\{(\w+)(?::\w+)?\}|\[\[(\w+)\]\]`)
+
+// substituteVariables returns a copy of a decoded JSON value whose strings have their template variables replaced.
+func substituteVariables(value interface{}, vars map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		substituted := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			substituted[key] = substituteVariables(value, vars)
+		}
+		return substituted
+	case []interface{}:
+		substituted := make([]interface{}, len(v))
+		for i, value := range v {
+			substituted[i] = substituteVariables(value, vars)
+		}
+		return substituted
+	case string:
+		return variablePattern.ReplaceAllStringFunc(v, func(match string) string {
+			groups := variablePattern.FindStringSubmatch(match)
+			for _, name := range groups[1:] {
+				if value, ok := vars[name]; ok && name != "" {
+					return value
+				}
+			}
+			return match
+		})
+	default:
+		return value
+	}
+}
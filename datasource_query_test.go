@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	panelQueryDashboardJSON = `{"dashboard": {"uid": "mixed", "panels": [
+		{"id": 1, "type": "row", "collapsed": true, "panels": [
+			{
+				"id": 2,
+				"type": "timeseries",
+				"datasource": {"type": "datasource", "uid": "-- Mixed --"},
+				"targets": [
+					{"refId": "A", "datasource": {"type": "prometheus", "uid": "prom"}, "expr": "up{env=\"$env\", job=\"${job}\"}"},
+					{"refId": "B", "datasource": "Loki", "expr": "{env=\"[[env]]\"} |= \"$unknown\""}
+				]
+			}
+		]},
+		{"id": 3, "type": "stat", "datasource": {"type": "prometheus", "uid": "prom"}, "targets": [{"refId": "A", "expr": "sum(up)"}]},
+		{"id": 5, "type": "stat", "datasource": null, "targets": [{"refId": "A", "expr": "count(up)"}]}
+	]}}`
+	dsQueryResponseJSON = `{"results": {
+		"A": {"status": 200, "frames": [{"schema": {"refId": "A", "fields": [{"name": "Time", "type": "time"}, {"name": "Value", "type": "number"}]}, "data": {"values": [[1685620800000], [1]]}}]},
+		"B": {"status": 400, "error": "parse error"}
+	}}`
+)
+
+func TestQueryDashboardPanel(t *testing.T) {
+	var req DSQueryRequest
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dashboards/uid/mixed":
+			fmt.Fprint(w, panelQueryDashboardJSON)
+		case "/api/datasources":
+			fmt.Fprint(w, `[{"id": 1, "uid": "prom", "name": "Prometheus", "type": "prometheus", "isDefault": false}, {"id": 3, "uid": "default-prom", "name": "Default", "type": "prometheus", "isDefault": true}]`)
+		case "/api/datasources/name/Loki":
+			fmt.Fprint(w, `{"id": 2, "uid": "loki", "name": "Loki", "type": "loki"}`)
+		case "/api/ds/query":
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			fmt.Fprint(w, dsQueryResponseJSON)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	from := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	resp, err := client.QueryDashboardPanel("mixed", 2, from, from.Add(time.Hour), map[string]string{"env": "prod", "job": "node"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(req))
+
+	if req.From != "1685620800000" || req.To != "1685624400000" || len(req.Queries) != 2 {
+		t.Fatalf("Invalid query request - %v", req)
+	}
+	if req.Queries[0]["expr"] != `up{env="prod", job="node"}` {
+		t.Errorf("Expected variables to be substituted, got %v", req.Queries[0]["expr"])
+	}
+	if req.Queries[1]["expr"] != `{env="prod"} |= "$unknown"` {
+		t.Errorf("Expected unknown variables to be left as is, got %v", req.Queries[1]["expr"])
+	}
+	if ds := req.Queries[1]["datasource"].(map[string]interface{}); ds["uid"] != "loki" || ds["type"] != "loki" {
+		t.Errorf("Expected the data source name to be resolved, got %v", ds)
+	}
+	if len(resp.Results["A"].Frames) != 1 || resp.Results["B"].Error != "parse error" {
+		t.Errorf("Not correctly parsing query results - %v", resp)
+	}
+
+	if _, err := client.QueryDashboardPanel("mixed", 3, from, from.Add(time.Hour), nil); err != nil {
+		t.Fatal(err)
+	}
+	if ds := req.Queries[0]["datasource"].(map[string]interface{}); ds["uid"] != "prom" {
+		t.Errorf("Expected the panel's data source to be used, got %v", ds)
+	}
+
+	if _, err := client.QueryDashboardPanel("mixed", 5, from, from.Add(time.Hour), nil); err != nil {
+		t.Fatal(err)
+	}
+	if ds := req.Queries[0]["datasource"].(map[string]interface{}); ds["uid"] != "default-prom" || ds["type"] != "prometheus" {
+		t.Errorf("Expected the default data source to be used, got %v", ds)
+	}
+
+	if _, err := client.QueryDashboardPanel("mixed", 4, from, from.Add(time.Hour), nil); err == nil {
+		t.Error("Expected an error for a missing panel")
+	}
+}
+
+func TestQueryDataSources_dryRun(t *testing.T) {
+	client := gapiTestTools(t, 200, dsQueryResponseJSON)
+	client.config.DryRun = true
+
+	resp, err := client.QueryDataSources(DSQueryRequest{
+		Queries: []map[string]interface{}{{"refId": "A", "datasource": map[string]string{"uid": "prom"}, "expr": "up"}},
+		From:    "now-1h",
+		To:      "now",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Results) != 2 {
+		t.Errorf("Expected queries to be sent in dry-run mode, got %v", resp.Results)
+	}
+}
@@ -1,6 +1,8 @@
 package gapi
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -88,6 +90,49 @@ func TestNewPrometheusDataSource(t *testing.T) {
 	}
 }
 
+func TestPrometheusJSONData(t *testing.T) {
+	manageAlerts := false
+	jd, err := PrometheusJSONData{
+		HTTPMethod:   "POST",
+		TimeInterval: "1m",
+		ManageAlerts: &manageAlerts,
+		ExemplarTraceIDDestinations: []PrometheusExemplarTraceIDDestination{
+			{Name: "traceID", DatasourceUID: "tempo"},
+		},
+	}.Map()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(jd))
+
+	if len(jd) != 4 || jd["httpMethod"] != "POST" || jd["timeInterval"] != "1m" || jd["manageAlerts"] != false {
+		t.Errorf("Unexpected jsonData: %v", jd)
+	}
+	destinations, ok := jd["exemplarTraceIdDestinations"].([]interface{})
+	if !ok || len(destinations) != 1 {
+		t.Errorf("Unexpected exemplar trace ID destinations: %v", jd["exemplarTraceIdDestinations"])
+	}
+}
+
+func TestCloudWatchJSONData(t *testing.T) {
+	jd, err := CloudWatchJSONData{AuthType: "keys", DefaultRegion: "eu-west-1"}.Map()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sjd, err := CloudWatchSecureJSONData{AccessKey: "access", SecretKey: "secret"}.Map()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(jd) != 2 || jd["authType"] != "keys" || jd["defaultRegion"] != "eu-west-1" {
+		t.Errorf("Unexpected jsonData: %v", jd)
+	}
+	if len(sjd) != 2 || sjd["accessKey"] != "access" || sjd["secretKey"] != "secret" {
+		t.Errorf("Unexpected secureJsonData: %v", sjd)
+	}
+}
+
 func TestNewPrometheusSigV4DataSource(t *testing.T) {
 	client := gapiTestTools(t, 200, createdDataSourceJSON)
 
@@ -317,3 +362,72 @@ func TestDeleteDataSourceByName(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDeleteDataSourceByUID(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/api/datasources/uid/foo" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"message":"Data source deleted"}`)
+	}))
+
+	err := client.DeleteDataSourceByUID("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteDataSource_notFound(t *testing.T) {
+	client := gapiTestTools(t, 404, `{"message":"Data source not found"}`)
+	if err := client.DeleteDataSourceByName("foo"); err != nil {
+		t.Errorf("expected a missing data source to be considered deleted; got: %v", err)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Data source not found"}`)
+	if err := client.DeleteDataSourceByUID("foo"); err != nil {
+		t.Errorf("expected a missing data source to be considered deleted; got: %v", err)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Data source not found"}`)
+	if err := client.DeleteDataSource(1); err != nil {
+		t.Errorf("expected a missing data source to be considered deleted; got: %v", err)
+	}
+
+	client = gapiTestTools(t, 403, `{"message":"Permission denied"}`)
+	if err := client.DeleteDataSourceByUID("foo"); err == nil {
+		t.Error("403 not detected")
+	}
+}
+
+func TestDataSourceByName_reservedCharacters(t *testing.T) {
+	for name, expectedPath := range map[string]string{
+		"prod/db":     "/api/datasources/name/prod%2Fdb",
+		"my db":       "/api/datasources/name/my%20db",
+		"100% uptime": "/api/datasources/name/100%25%20uptime",
+		"foo":         "/api/datasources/name/foo",
+	} {
+		client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.EscapedPath() != expectedPath {
+				t.Errorf("Invalid path - %s, Expected %s", r.URL.EscapedPath(), expectedPath)
+			}
+			fmt.Fprint(w, getDataSourceJSON)
+		}))
+
+		ds, err := client.DataSourceByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ds.ID != 1 {
+			t.Error("Not correctly parsing returned datasource.")
+		}
+	}
+}
+
+func TestRequestURL_unescapedPath(t *testing.T) {
+	client := gapiTestTools(t, 200, "")
+
+	u := client.requestURL("/api/v1/provisioning/templates/50% CPU", nil)
+	if u.String() != "http://my-grafana.com/api/v1/provisioning/templates/50%25%20CPU" {
+		t.Errorf("Invalid URL - %s", u.String())
+	}
+}
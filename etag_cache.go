@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"net/http"
+	"sync"
+)
+
+// etagCache stores the bodies of GET responses along with their ETag, keyed by request.
+// A nil cache is valid and caches nothing.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagCacheEntry
+}
+
+type etagCacheEntry struct {
+	etag string
+	body []byte
+}
+
+func newETagCache() *etagCache {
+	return &etagCache{entries: make(map[string]etagCacheEntry)}
+}
+
+// etagCacheKey identifies a request by its URL and the organization it targets.
+func etagCacheKey(req *http.Request) string {
+	return req.Header.Get("X-Grafana-Org-Id") + " " + req.URL.String()
+}
+
+func (c *etagCache) get(key string) (etagCacheEntry, bool) {
+	if c == nil {
+		return etagCacheEntry{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *etagCache) set(key, etag string, body []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = etagCacheEntry{etag: etag, body: body}
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRequest_etagCache(t *testing.T) {
+	calls := 0
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if calls > 1 {
+			t.Errorf("Expected If-None-Match on request %d", calls)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, getDashboardResponse)
+	}))
+	client.etags = newETagCache()
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.DashboardByUID("cIBgcSjkk")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uid := resp.Model["uid"]; uid != "cIBgcSjkk" {
+			t.Errorf("Invalid uid on request %d - %s, Expected %s", i+1, uid, "cIBgcSjkk")
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+}
+
+func TestRequest_etagCacheDisabled(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Error("Expected no If-None-Match header")
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, getDashboardResponse)
+	}))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.DashboardByUID("cIBgcSjkk"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -9,10 +9,19 @@ import (
 
 // Folder represents a Grafana folder.
 type Folder struct {
-	ID    int64  `json:"id"`
-	UID   string `json:"uid"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+	ID        int64  `json:"id"`
+	UID       string `json:"uid"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	ParentUID string `json:"parentUid,omitempty"`
+}
+
+// FolderNode represents a Grafana folder within the folder tree.
+type FolderNode struct {
+	Folder
+	// Path is the slash separated path of folder titles from the root, e.g. Payments/Production.
+	Path     string       `json:"path"`
+	Children []FolderNode `json:"children,omitempty"`
 }
 
 type FolderPayload struct {
@@ -47,6 +56,96 @@ func (c *Client) Folders() ([]Folder, error) {
 	}
 }
 
+// FolderTree fetches all folders, including nested ones, and returns them as a tree of root folders.
+// Folders whose parent can't be found, e.g. because it isn't visible to the client, are returned as roots.
+func (c *Client) FolderTree() ([]FolderNode, error) {
+	results, err := c.SearchFolders("")
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make(map[string]Folder, len(results))
+	for _, r := range results {
+		folders[r.UID] = Folder{
+			ID:        int64(r.ID),
+			UID:       r.UID,
+			Title:     r.Title,
+			URL:       r.URL,
+			ParentUID: r.FolderUID,
+		}
+	}
+
+	children := make(map[string][]Folder)
+	var roots []Folder
+	for _, r := range results {
+		f := folders[r.UID]
+		if _, ok := folders[f.ParentUID]; ok && f.ParentUID != f.UID {
+			children[f.ParentUID] = append(children[f.ParentUID], f)
+		} else {
+			roots = append(roots, f)
+		}
+	}
+
+	visited := make(map[string]bool, len(folders))
+	var build func(f Folder, parentPath string) FolderNode
+	build = func(f Folder, parentPath string) FolderNode {
+		visited[f.UID] = true
+		node := FolderNode{Folder: f, Path: f.Title}
+		if parentPath != "" {
+			node.Path = parentPath + "/" + f.Title
+		}
+		for _, child := range children[f.UID] {
+			if !visited[child.UID] {
+				node.Children = append(node.Children, build(child, node.Path))
+			}
+		}
+		return node
+	}
+
+	tree := make([]FolderNode, 0, len(roots))
+	for _, f := range roots {
+		tree = append(tree, build(f, ""))
+	}
+	// Folders only reachable through a parent cycle, which Grafana shouldn't produce, become roots.
+	for _, r := range results {
+		if !visited[r.UID] {
+			tree = append(tree, build(folders[r.UID], ""))
+		}
+	}
+
+	return tree, nil
+}
+
+// FolderUIDByTitle returns the UID of the folder with the given title.
+// An error is returned if no folder or more than one folder has this title.
+func (c *Client) FolderUIDByTitle(title string) (string, error) {
+	if uid, ok := c.folderUIDs.get(c.config.OrgID, title); ok {
+		return uid, nil
+	}
+
+	results, err := c.SearchFolders(title)
+	if err != nil {
+		return "", err
+	}
+
+	var uid string
+	for _, r := range results {
+		if r.Title != title {
+			continue
+		}
+		if uid != "" {
+			return "", fmt.Errorf("more than one folder with title %s found", title)
+		}
+		uid = r.UID
+	}
+	if uid == "" {
+		return "", fmt.Errorf("folder with title %s not found", title)
+	}
+
+	c.folderUIDs.set(c.config.OrgID, title, uid)
+	return uid, nil
+}
+
 // Folder fetches and returns the Grafana folder whose ID it's passed.
 func (c *Client) Folder(id int64) (*Folder, error) {
 	folder := &Folder{}
@@ -69,6 +168,30 @@ func (c *Client) FolderByUID(uid string) (*Folder, error) {
 	return folder, err
 }
 
+// FolderCounts holds the number of items contained in a folder, including those in its subfolders.
+type FolderCounts struct {
+	Folder       int64 `json:"folder"`
+	Dashboard    int64 `json:"dashboard"`
+	LibraryPanel int64 `json:"librarypanel"`
+	AlertRule    int64 `json:"alertrule"`
+}
+
+// FolderCounts fetches and returns the number of subfolders, dashboards, library panels and alert rules
+// in the Grafana folder whose UID it's passed. It requires Grafana 10.
+func (c *Client) FolderCounts(uid string) (*FolderCounts, error) {
+	if err := c.requireMajorVersion(10, "folder counts"); err != nil {
+		return nil, err
+	}
+
+	counts := &FolderCounts{}
+	err := c.request("GET", fmt.Sprintf("/api/folders/%s/counts", uid), nil, nil, counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 // NewFolder creates a new Grafana folder.
 func (c *Client) NewFolder(title string, uid ...string) (Folder, error) {
 	if len(uid) > 1 {
@@ -91,6 +214,7 @@ func (c *Client) NewFolder(title string, uid ...string) (Folder, error) {
 	if err != nil {
 		return folder, err
 	}
+	c.folderUIDs.invalidate()
 
 	return folder, err
 }
@@ -109,7 +233,13 @@ func (c *Client) UpdateFolder(uid string, title string, newUID ...string) error
 		return err
 	}
 
-	return c.request("PUT", fmt.Sprintf("/api/folders/%s", uid), nil, bytes.NewBuffer(data), nil)
+	err = c.request("PUT", fmt.Sprintf("/api/folders/%s", uid), nil, bytes.NewBuffer(data), nil)
+	if err != nil {
+		return err
+	}
+	c.folderUIDs.invalidate()
+
+	return nil
 }
 
 func ForceDeleteFolderRules() url.Values {
@@ -118,14 +248,23 @@ func ForceDeleteFolderRules() url.Values {
 	return query
 }
 
-// DeleteFolder deletes the folder whose ID it's passed.
-func (c *Client) DeleteFolder(id string, optionalQueryParams ...url.Values) error {
-	var query url.Values
-	for _, param := range optionalQueryParams {
-		for paramKey := range param {
-			query.Set(paramKey, param.Get(paramKey))
-		}
+// DeleteFolderResponse represents the response to a folder deletion.
+type DeleteFolderResponse struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
+// DeleteFolder deletes the folder whose UID it's passed, along with its dashboards, and with
+// ForceDeleteFolderRules its alert rules. Grafana doesn't report how many of them were deleted;
+// FolderCounts can be used beforehand to find out.
+func (c *Client) DeleteFolder(id string, optionalQueryParams ...url.Values) (*DeleteFolderResponse, error) {
+	resp := &DeleteFolderResponse{}
+	err := c.request("DELETE", fmt.Sprintf("/api/folders/%s", id), mergeQueryParams(optionalQueryParams), nil, resp)
+	if err != nil {
+		return nil, err
 	}
+	c.folderUIDs.invalidate()
 
-	return c.request("DELETE", fmt.Sprintf("/api/folders/%s", id), query, nil, nil)
+	return resp, nil
 }
@@ -1,24 +1,36 @@
 package gapi
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 )
 
 // FolderDashboardSearchResponse represents the Grafana API dashboard search response.
+// Each result is either a dashboard (Type "dash-db") or a folder (Type "dash-folder").
 type FolderDashboardSearchResponse struct {
-	ID          uint     `json:"id"`
-	UID         string   `json:"uid"`
-	Title       string   `json:"title"`
-	URI         string   `json:"uri"`
-	URL         string   `json:"url"`
-	Slug        string   `json:"slug"`
-	Type        string   `json:"type"`
-	Tags        []string `json:"tags"`
-	IsStarred   bool     `json:"isStarred"`
-	FolderID    uint     `json:"folderId"`
-	FolderUID   string   `json:"folderUid"`
-	FolderTitle string   `json:"folderTitle"`
-	FolderURL   string   `json:"folderUrl"`
+	ID    uint   `json:"id"`
+	UID   string `json:"uid"`
+	Title string `json:"title"`
+	// URI is the deprecated db/<slug> path of the result.
+	URI  string `json:"uri"`
+	URL  string `json:"url"`
+	Slug string `json:"slug"`
+	// Type is either dash-db or dash-folder.
+	Type      string   `json:"type"`
+	Tags      []string `json:"tags"`
+	IsStarred bool     `json:"isStarred"`
+
+	// The folder fields describe the folder containing the result. They are empty for results in the General folder.
+	FolderID    uint   `json:"folderId"`
+	FolderUID   string `json:"folderUid"`
+	FolderTitle string `json:"folderTitle"`
+	FolderURL   string `json:"folderUrl"`
+
+	// SortMeta is the value results were sorted by when searching with a sort option such as views-recent,
+	// and SortMetaName its name.
+	SortMeta     int64  `json:"sortMeta"`
+	SortMetaName string `json:"sortMetaName,omitempty"`
 }
 
 // FolderDashboardSearch uses the folder and dashboard search endpoint to find
@@ -27,3 +39,68 @@ func (c *Client) FolderDashboardSearch(params url.Values) (resp []FolderDashboar
 	err = c.request("GET", "/api/search", params, nil, &resp)
 	return
 }
+
+// SearchFolders uses the folder and dashboard search endpoint to find all folders,
+// including nested ones, matching the query. An empty query matches all folders.
+func (c *Client) SearchFolders(query string) ([]FolderDashboardSearchResponse, error) {
+	params := make(url.Values)
+	params.Set("type", "dash-folder")
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	return c.folderDashboardSearchAll(params)
+}
+
+// ErrStopWalk can be returned by the functions passed to WalkDashboards to stop walking without an error.
+var ErrStopWalk = errors.New("stop walk")
+
+// folderDashboardSearchAll pages through the folder and dashboard search endpoint and returns all results.
+func (c *Client) folderDashboardSearchAll(params url.Values) ([]FolderDashboardSearchResponse, error) {
+	var results []FolderDashboardSearchResponse
+	err := c.folderDashboardSearchWalk(params, func(result FolderDashboardSearchResponse) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// folderDashboardSearchWalk pages through the folder and dashboard search endpoint and calls fn for each result,
+// holding a single page in memory. It stops at the first error returned by fn.
+func (c *Client) folderDashboardSearchWalk(params url.Values, fn func(FolderDashboardSearchResponse) error) error {
+	const limit = 1000
+
+	var (
+		page       = 0
+		newResults []FolderDashboardSearchResponse
+		query      = make(url.Values)
+	)
+
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("limit", fmt.Sprint(limit))
+
+	for {
+		page++
+		query.Set("page", fmt.Sprint(page))
+
+		if err := c.request("GET", "/api/search", query, nil, &newResults); err != nil {
+			return err
+		}
+
+		for _, result := range newResults {
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+
+		if len(newResults) < limit {
+			return nil
+		}
+	}
+}
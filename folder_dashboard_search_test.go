@@ -1,6 +1,7 @@
 package gapi
 
 import (
+	"encoding/json"
 	"net/url"
 	"testing"
 )
@@ -60,3 +61,56 @@ func TestFolderDashboardSearch(t *testing.T) {
 		t.Error("Not correctly parsing response.")
 	}
 }
+
+func TestSearchFolders(t *testing.T) {
+	client := gapiTestTools(t, 200, `[
+		{
+			"id": 163,
+			"uid": "000000163",
+			"title": "Folder",
+			"url": "/dashboards/f/000000163/folder",
+			"type": "dash-folder",
+			"tags": [],
+			"isStarred": false,
+			"uri":"db/folder"
+		}
+	]`)
+	resp, err := client.SearchFolders("Fol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 {
+		t.Errorf("Expected 1 object in response, got %d", len(resp))
+	}
+	if resp[0].Type != "dash-folder" || resp[0].Title != "Folder" {
+		t.Error("Not correctly parsing response.")
+	}
+}
+
+func TestFolderDashboardSearchResponse_sortMeta(t *testing.T) {
+	// This response is copied from a search of Grafana 10 sorted by views.
+	const searchJSON = `[{"id":12,"uid":"cIBgcSjkk","title":"Production Overview","uri":"db/production-overview","url":"/d/cIBgcSjkk/production-overview","slug":"","type":"dash-db","tags":["prod"],"isStarred":false,"folderId":2,"folderUid":"000000163","folderTitle":"Folder","folderUrl":"/dashboards/f/000000163/folder","sortMeta":42,"sortMetaName":"Views last 30 days"},{"id":163,"uid":"000000163","title":"Folder","uri":"db/folder","url":"/dashboards/f/000000163/folder","slug":"","type":"dash-folder","tags":[],"isStarred":false,"sortMeta":0}]`
+
+	var results []FolderDashboardSearchResponse
+	if err := json.Unmarshal([]byte(searchJSON), &results); err != nil {
+		t.Fatal(err)
+	}
+	if results[0].FolderUID != "000000163" || results[0].SortMeta != 42 || results[0].SortMetaName != "Views last 30 days" {
+		t.Errorf("Not correctly parsing response: %v", results[0])
+	}
+
+	// The folder fields are marshalled even when empty, as they always have been.
+	data, err := json.Marshal(results[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual map[string]interface{}
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"folderId", "folderUid", "folderTitle", "folderUrl"} {
+		if _, ok := actual[key]; !ok {
+			t.Errorf("Expected %s to be marshalled, got %s", key, data)
+		}
+	}
+}
@@ -8,40 +8,21 @@ import (
 
 // FolderPermission has information such as a folder, user, team, role and permission.
 type FolderPermission struct {
-	ID        int64  `json:"id"`
-	FolderUID string `json:"uid"`
-	UserID    int64  `json:"userId"`
-	TeamID    int64  `json:"teamId"`
-	Role      string `json:"role"`
-	IsFolder  bool   `json:"isFolder"`
+	ID        int64   `json:"id"`
+	FolderUID string  `json:"uid"`
+	UserID    int64   `json:"userId"`
+	TeamID    int64   `json:"teamId"`
+	Role      OrgRole `json:"role"`
+	IsFolder  bool    `json:"isFolder"`
 
-	// Permission levels are
-	// 1 = View
-	// 2 = Edit
-	// 4 = Admin
-	Permission     int64  `json:"permission"`
-	PermissionName string `json:"permissionName"`
+	Permission     PermissionLevel `json:"permission"`
+	PermissionName string          `json:"permissionName"`
 
 	// optional fields
 	FolderID    int64 `json:"folderId,omitempty"`
 	DashboardID int64 `json:"dashboardId,omitempty"`
 }
 
-// PermissionItems represents Grafana folder permission items.
-type PermissionItems struct {
-	Items []*PermissionItem `json:"items"`
-}
-
-// PermissionItem represents a Grafana folder permission item.
-type PermissionItem struct {
-	// As you can see the docs, each item has a pair of [Role|TeamID|UserID] and Permission.
-	// unnecessary fields are omitted.
-	Role       string `json:"role,omitempty"`
-	TeamID     int64  `json:"teamId,omitempty"`
-	UserID     int64  `json:"userId,omitempty"`
-	Permission int64  `json:"permission"`
-}
-
 // FolderPermissions fetches and returns the permissions for the folder whose ID it's passed.
 func (c *Client) FolderPermissions(fid string) ([]*FolderPermission, error) {
 	permissions := make([]*FolderPermission, 0)
@@ -63,3 +44,49 @@ func (c *Client) UpdateFolderPermissions(fid string, items *PermissionItems) err
 
 	return c.request("POST", path, nil, bytes.NewBuffer(data), nil)
 }
+
+// ClearFolderPermissions removes all permissions set on the folder whose UID it's passed, leaving only the
+// permissions it inherits from its parent folders, if any. This includes the default Editor and Viewer role
+// permissions of new folders, so that a top-level folder is then only accessible to admins.
+func (c *Client) ClearFolderPermissions(uid string) error {
+	return c.UpdateFolderPermissions(uid, &PermissionItems{Items: []*PermissionItem{}})
+}
+
+// GrantTeamFolderAccess adds or updates the permission of a team on the folder whose UID it's passed,
+// keeping all other existing permissions on the folder.
+// Since updating folder permissions replaces all of them, the current permissions are read and written back.
+// It returns the folder's permissions after the update.
+func (c *Client) GrantTeamFolderAccess(teamID int64, folderUID string, permission PermissionLevel) ([]*FolderPermission, error) {
+	current, err := c.FolderPermissions(folderUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := &PermissionItems{Items: make([]*PermissionItem, 0, len(current)+1)}
+	found := false
+	for _, p := range current {
+		item := &PermissionItem{
+			Role:       p.Role,
+			TeamID:     p.TeamID,
+			UserID:     p.UserID,
+			Permission: p.Permission,
+		}
+		if p.TeamID == teamID {
+			if found {
+				continue
+			}
+			item.Permission = permission
+			found = true
+		}
+		items.Items = append(items.Items, item)
+	}
+	if !found {
+		items.Items = append(items.Items, &PermissionItem{TeamID: teamID, Permission: permission})
+	}
+
+	if err := c.UpdateFolderPermissions(folderUID, items); err != nil {
+		return nil, err
+	}
+
+	return c.FolderPermissions(folderUID)
+}
@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -131,3 +135,64 @@ func TestUpdateFolderPermissions(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestPermissionItemJSON(t *testing.T) {
+	data, err := json.Marshal(PermissionItem{Role: RoleEditor, Permission: PermissionAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"role":"Editor","permission":4}`
+	if string(data) != expected {
+		t.Errorf("expected: %s; got: %s", expected, data)
+	}
+}
+
+func TestGrantTeamFolderAccess(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, getFolderPermissionsJSON},
+		{200, updateFolderPermissionsJSON},
+		{200, getFolderPermissionsJSON},
+	})
+
+	resp, err := client.GrantTeamFolderAccess(1, "nErXDvCkzz", PermissionEdit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(resp))
+
+	if len(resp) != 2 {
+		t.Errorf("Expected 2 permissions, got %d", len(resp))
+	}
+
+	for _, code := range []int{401, 403, 404} {
+		client = gapiTestTools(t, code, "error")
+		_, err = client.GrantTeamFolderAccess(1, "nErXDvCkzz", PermissionEdit)
+		if err == nil {
+			t.Errorf("%d not detected", code)
+		}
+	}
+}
+
+func TestClearFolderPermissions(t *testing.T) {
+	var body string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/folders/nErXDvCkzz/permissions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body = string(data)
+		fmt.Fprint(w, updateFolderPermissionsJSON)
+	}))
+
+	if err := client.ClearFolderPermissions("nErXDvCkzz"); err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"items":[]}` {
+		t.Errorf("expected an empty list of items; got %s", body)
+	}
+}
@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -76,7 +80,9 @@ const (
 `
 	deletedFolderJSON = `
 {
-  "message":"Folder deleted"
+  "id": 1,
+  "title": "Departmenet ABC",
+  "message": "Folder Departmenet ABC deleted"
 }
 `
 )
@@ -144,6 +150,33 @@ func TestFolderByUid(t *testing.T) {
 	}
 }
 
+func TestFolderCounts(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, `{"folder":1,"dashboard":4,"librarypanel":2,"alertrule":3}`},
+	})
+
+	counts, err := client.FolderCounts("nErXDvCkzz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(counts))
+
+	if counts.Folder != 1 || counts.Dashboard != 4 || counts.LibraryPanel != 2 || counts.AlertRule != 3 {
+		t.Error("Not correctly parsing returned folder counts.")
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"database": "ok", "version": "9.5.2"}`},
+		{200, frontendSettingsJSON},
+	})
+	if _, err := client.FolderCounts("nErXDvCkzz"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected unsupported version error, got %v", err)
+	}
+}
+
 func TestNewFolder(t *testing.T) {
 	client := gapiTestTools(t, 200, createdFolderJSON)
 
@@ -171,8 +204,61 @@ func TestUpdateFolder(t *testing.T) {
 func TestDeleteFolder(t *testing.T) {
 	client := gapiTestTools(t, 200, deletedFolderJSON)
 
-	err := client.DeleteFolder("nErXDvCkzz")
+	resp, err := client.DeleteFolder("nErXDvCkzz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(resp))
+
+	if resp.ID != 1 || resp.Title != "Departmenet ABC" || resp.Message != "Folder Departmenet ABC deleted" {
+		t.Error("Not correctly parsing returned deletion message.")
+	}
+
+	var query url.Values
+	client = gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		fmt.Fprint(w, deletedFolderJSON)
+	}))
+	if _, err := client.DeleteFolder("nErXDvCkzz", ForceDeleteFolderRules()); err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("forceDeleteRules") != "true" {
+		t.Errorf("Expected forceDeleteRules query parameter, got %v", query)
+	}
+}
+
+func TestFolderTree(t *testing.T) {
+	client := gapiTestTools(t, 200, `[
+		{"id": 1, "uid": "payments", "title": "Payments", "type": "dash-folder"},
+		{"id": 2, "uid": "prod", "title": "Production", "type": "dash-folder", "folderUid": "payments"},
+		{"id": 3, "uid": "eu", "title": "EU", "type": "dash-folder", "folderUid": "prod"},
+		{"id": 4, "uid": "orphan", "title": "Orphan", "type": "dash-folder", "folderUid": "hidden"},
+		{"id": 5, "uid": "a", "title": "A", "type": "dash-folder", "folderUid": "b"},
+		{"id": 6, "uid": "b", "title": "B", "type": "dash-folder", "folderUid": "a"}
+	]`)
+
+	tree, err := client.FolderTree()
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	t.Log(pretty.PrettyFormat(tree))
+
+	if len(tree) != 3 {
+		t.Fatalf("Expected 3 root folders, got %d", len(tree))
+	}
+	if tree[0].Path != "Payments" || len(tree[0].Children) != 1 {
+		t.Errorf("Invalid root folder - %v", tree[0])
+	}
+	eu := tree[0].Children[0].Children[0]
+	if eu.Path != "Payments/Production/EU" || eu.ParentUID != "prod" {
+		t.Errorf("Invalid nested folder - %v", eu)
+	}
+	if tree[1].Path != "Orphan" {
+		t.Errorf("Expected orphan folder as root, got %v", tree[1])
+	}
+	if tree[2].Path != "A" || len(tree[2].Children) != 1 || tree[2].Children[0].Path != "A/B" {
+		t.Errorf("Expected cycle to be broken, got %v", tree[2])
+	}
 }
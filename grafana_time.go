@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GrafanaTime is a timestamp decoded from either of the formats Grafana uses in responses:
+// an RFC 3339 string or a number of milliseconds since the Unix epoch.
+// It is encoded as an RFC 3339 string.
+type GrafanaTime time.Time
+
+// Time returns the timestamp as a time.Time.
+func (t GrafanaTime) Time() time.Time {
+	return time.Time(t)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t GrafanaTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(t).Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *GrafanaTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*t = GrafanaTime{}
+		return nil
+	}
+
+	if data[0] == '"' {
+		var parsed time.Time
+		if err := json.Unmarshal(data, &parsed); err != nil {
+			return err
+		}
+		*t = GrafanaTime(parsed)
+		return nil
+	}
+
+	var millis int64
+	if err := json.Unmarshal(data, &millis); err != nil {
+		return fmt.Errorf("invalid timestamp %s: %w", data, err)
+	}
+	*t = GrafanaTime(time.UnixMilli(millis).UTC())
+	return nil
+}
@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGrafanaTime(t *testing.T) {
+	expected := time.Date(2023, 6, 12, 15, 4, 5, 0, time.UTC)
+
+	for _, data := range []string{`"2023-06-12T15:04:05Z"`, `"2023-06-12T17:04:05+02:00"`, `1686582245000`} {
+		var ts GrafanaTime
+		if err := json.Unmarshal([]byte(data), &ts); err != nil {
+			t.Fatal(err)
+		}
+		if !ts.Time().Equal(expected) {
+			t.Errorf("%s decoded as %s, expected %s", data, ts.Time(), expected)
+		}
+	}
+
+	for _, data := range []string{`null`, `""`} {
+		var ts GrafanaTime
+		if err := json.Unmarshal([]byte(data), &ts); err != nil {
+			t.Fatal(err)
+		}
+		if !ts.Time().IsZero() {
+			t.Errorf("%s decoded as %s, expected zero time", data, ts.Time())
+		}
+	}
+
+	var ts GrafanaTime
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("Expected an error for an invalid timestamp")
+	}
+
+	data, err := json.Marshal(GrafanaTime(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"2023-06-12T15:04:05Z"` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+}
@@ -1,5 +1,21 @@
 package gapi
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnsupportedVersion is returned when calling an API the Grafana server is too old to serve.
+var ErrUnsupportedVersion = errors.New("unsupported Grafana version")
+
+// ErrEnterpriseRequired is returned when calling an API only Grafana Enterprise serves on another edition.
+var ErrEnterpriseRequired = errors.New("grafana enterprise required")
+
 type HealthResponse struct {
 	Commit   string `json:"commit,omitempty"`
 	Database string `json:"database,omitempty"`
@@ -14,3 +30,146 @@ func (c *Client) Health() (HealthResponse, error) {
 	}
 	return health, nil
 }
+
+// WaitForReady polls the health endpoint every interval until Grafana reports its database as ok,
+// e.g. once database migrations are done after startup. Errors while polling, such as 503 responses,
+// are retried. Use a context with a timeout or deadline to bound the wait.
+func (c *Client) WaitForReady(ctx context.Context, interval time.Duration) error {
+	ctx, cancel := c.withCallTimeout(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	client := c.WithContext(ctx)
+	for {
+		health, err := client.Health()
+		if err == nil && health.Database == "ok" {
+			return nil
+		}
+		if err == nil {
+			err = fmt.Errorf("database is %q", health.Database)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("grafana not ready: %w, last health check: %v", ctx.Err(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Editions reported in BuildInfo.Edition.
+const (
+	EditionOSS        = "Open Source"
+	EditionEnterprise = "Enterprise"
+)
+
+// BuildInfo describes the build of the Grafana server.
+type BuildInfo struct {
+	Version       string `json:"version"`
+	Commit        string `json:"commit"`
+	Edition       string `json:"edition"`
+	HasUpdate     bool   `json:"hasUpdate"`
+	LatestVersion string `json:"latestVersion"`
+}
+
+// IsEnterprise returns whether the server runs Grafana Enterprise, which serves Enterprise-only endpoints.
+func (b BuildInfo) IsEnterprise() bool {
+	return b.Edition == EditionEnterprise
+}
+
+// buildInfoCache holds the build info once fetched. It is shared by copies of a client, as they target the same server.
+type buildInfoCache struct {
+	mu   sync.Mutex
+	info *BuildInfo
+}
+
+// BuildInfo fetches and returns the version, commit and edition of the Grafana server, combining the health
+// endpoint with the build info of the frontend settings. The result is cached by the client.
+func (c *Client) BuildInfo() (*BuildInfo, error) {
+	if c.buildInfo != nil {
+		c.buildInfo.mu.Lock()
+		defer c.buildInfo.mu.Unlock()
+		if c.buildInfo.info != nil {
+			info := *c.buildInfo.info
+			return &info, nil
+		}
+	}
+
+	health, err := c.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	settings := struct {
+		BuildInfo BuildInfo `json:"buildInfo"`
+	}{}
+	if err := c.request("GET", "/api/frontend/settings", nil, nil, &settings); err != nil {
+		return nil, err
+	}
+
+	info := settings.BuildInfo
+	// The frontend settings hide the version from anonymous users when configured to.
+	if health.Version != "" {
+		info.Version = health.Version
+	}
+	if health.Commit != "" {
+		info.Commit = health.Commit
+	}
+
+	if c.buildInfo != nil {
+		cached := info
+		c.buildInfo.info = &cached
+	}
+
+	return &info, nil
+}
+
+// requireMajorVersion returns an error wrapping ErrUnsupportedVersion if the Grafana server is older than
+// the given major version. Servers whose version can't be parsed are let through.
+func (c *Client) requireMajorVersion(major int, feature string) error {
+	return c.requireVersion(major, 0, feature)
+}
+
+// requireVersion returns an error wrapping ErrUnsupportedVersion if the Grafana server is older than
+// the given major and minor version. Servers whose version can't be parsed are let through.
+func (c *Client) requireVersion(major, minor int, feature string) error {
+	info, err := c.BuildInfo()
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(info.Version, ".", 3)
+	serverMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil
+	}
+	serverMinor := 0
+	if len(parts) > 1 {
+		if serverMinor, err = strconv.Atoi(parts[1]); err != nil {
+			return nil
+		}
+	}
+
+	if serverMajor < major || (serverMajor == major && serverMinor < minor) {
+		required := strconv.Itoa(major)
+		if minor > 0 {
+			required = fmt.Sprintf("%d.%d", major, minor)
+		}
+		return fmt.Errorf("%w: %s require Grafana %s, server runs %s", ErrUnsupportedVersion, feature, required, info.Version)
+	}
+	return nil
+}
+
+// requireEnterprise returns an error wrapping ErrEnterpriseRequired if the Grafana server doesn't run Grafana Enterprise.
+func (c *Client) requireEnterprise(feature string) error {
+	info, err := c.BuildInfo()
+	if err != nil {
+		return err
+	}
+	if !info.IsEnterprise() {
+		return fmt.Errorf("%w: %s are not available in the %s edition", ErrEnterpriseRequired, feature, info.Edition)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const (
+	healthOKJSON         = `{"commit": "087143285", "database": "ok", "version": "10.0.0"}`
+	frontendSettingsJSON = `{
+		"appUrl": "http://localhost:3000/",
+		"buildInfo": {
+			"hideVersion": false,
+			"version": "10.0.0",
+			"commit": "087143285",
+			"buildstamp": 1686578400,
+			"edition": "Enterprise",
+			"latestVersion": "10.0.1",
+			"hasUpdate": true,
+			"env": "production"
+		}
+	}`
+)
+
+func TestHealth(t *testing.T) {
+	client := gapiTestTools(t, 200, healthOKJSON)
+
+	health, err := client.Health()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.Database != "ok" || health.Version != "10.0.0" {
+		t.Errorf("Not correctly parsing returned health: %v", health)
+	}
+}
+
+func TestWaitForReady(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{503, `{"database": "failing"}`},
+		{200, `{"database": "failing"}`},
+		{200, healthOKJSON},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.WaitForReady(ctx, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForReady_timeout(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{503, `{"database": "failing"}`},
+		{503, `{"database": "failing"}`},
+		{503, `{"database": "failing"}`},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Millisecond)
+	defer cancel()
+
+	err := client.WaitForReady(ctx, 10*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWaitForReady_clientTimeout(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"database": "failing"}`)
+	}))
+
+	start := time.Now()
+	err := client.WithTimeout(30*time.Millisecond).WaitForReady(context.Background(), 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Expected the wait to be bounded by the client timeout, got %v after %s", err, time.Since(start))
+	}
+}
+
+func TestWaitForReady_hungRequest(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, healthOKJSON)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := client.WaitForReady(ctx, time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if time.Since(start) > 150*time.Millisecond {
+		t.Errorf("Expected a hung health check to be cancelled at the deadline, waited %s", time.Since(start))
+	}
+}
+
+func TestBuildInfo(t *testing.T) {
+	// Only the first call is served, the second one is answered from the cache.
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+	})
+
+	for i := 0; i < 2; i++ {
+		info, err := client.BuildInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Version != "10.0.0" || info.Commit != "087143285" || !info.HasUpdate || info.LatestVersion != "10.0.1" {
+			t.Errorf("Not correctly parsing returned build info: %v", info)
+		}
+		if !info.IsEnterprise() {
+			t.Errorf("Expected Enterprise edition, got %s", info.Edition)
+		}
+	}
+}
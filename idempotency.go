@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// idempotencyKeyHeader is the header carrying the idempotency key of POST requests.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey returns a new client which sends the provided key in the Idempotency-Key header of its
+// POST requests, including their retries, so that a retried create can be told apart from a new one, by Grafana
+// if it honors the header, or by the caller correlating the key. An empty key is replaced by a random UUID,
+// which IdempotencyKey returns. Use a new derived client for each create.
+// Keys must be printable ASCII, POST requests fail otherwise.
+func (c Client) WithIdempotencyKey(key string) *Client {
+	if key == "" {
+		key = newUUID()
+	}
+
+	clone := c.Clone()
+	clone.idempotencyKey = key
+	return clone
+}
+
+// IdempotencyKey returns the key the client sends in the Idempotency-Key header of its POST requests,
+// if it was derived with WithIdempotencyKey.
+func (c *Client) IdempotencyKey() string {
+	return c.idempotencyKey
+}
+
+// checkIdempotencyKey returns an error if an idempotency key isn't printable ASCII, which a header can't carry.
+func checkIdempotencyKey(key string) error {
+	for i := 0; i < len(key); i++ {
+		if key[i] < ' ' || key[i] > '~' {
+			return fmt.Errorf("invalid idempotency key %q: keys must be printable ASCII", key)
+		}
+	}
+	return nil
+}
+
+// newUUID returns a random (version 4) UUID. It panics if the system's random number generator fails.
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate a random UUID: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestClient_WithIdempotencyKey(t *testing.T) {
+	var keys []string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		fmt.Fprint(w, `{}`)
+	}))
+
+	derived := client.WithIdempotencyKey("")
+	key := derived.IdempotencyKey()
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(key) {
+		t.Errorf("expected a random UUID; got: %s", key)
+	}
+
+	for _, method := range []string{"POST", "GET"} {
+		if err := derived.request(method, "/foo", nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := client.request("POST", "/foo", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 || keys[0] != key || keys[1] != "" || keys[2] != "" {
+		t.Errorf("expected the key to be sent on the derived client's POST requests only; got: %v", keys)
+	}
+
+	if key := client.WithIdempotencyKey("annotation-42").IdempotencyKey(); key != "annotation-42" {
+		t.Errorf("expected the provided key; got: %s", key)
+	}
+
+	if err := client.WithIdempotencyKey("bad\nkey").request("POST", "/foo", nil, nil, nil); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+	if len(keys) != 3 {
+		t.Errorf("expected the request with an invalid key not to be sent; got: %v", keys)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -61,12 +62,14 @@ type LibraryPanelDeleteResponse struct {
 
 // LibraryPanelConnection represents a Grafana connection between a library panel and a dashboard.
 type LibraryPanelConnection struct {
-	ID          int64                `json:"id"`
-	Kind        int64                `json:"kind"`
-	PanelID     int64                `json:"elementId"`
-	DashboardID int64                `json:"connectionId"`
-	Created     time.Time            `json:"created"`
-	CreatedBy   LibraryPanelMetaUser `json:"createdBy"`
+	ID          int64 `json:"id"`
+	Kind        int64 `json:"kind"`
+	PanelID     int64 `json:"elementId"`
+	DashboardID int64 `json:"connectionId"`
+	// DashboardUID is only returned by Grafana 9 and later.
+	DashboardUID string               `json:"connectionUid,omitempty"`
+	Created      time.Time            `json:"created"`
+	CreatedBy    LibraryPanelMetaUser `json:"createdBy"`
 }
 
 // NewLibraryPanel creates a new Grafana library panel.
@@ -117,7 +120,7 @@ func (c *Client) LibraryPanelByName(name string) (*LibraryPanel, error) {
 	var resp struct {
 		Result []LibraryPanel `json:"result"`
 	}
-	path := fmt.Sprintf("/api/library-elements/name/%s", name)
+	path := fmt.Sprintf("/api/library-elements/name/%s", url.PathEscape(name))
 
 	err := c.request("GET", path, nil, nil, &resp)
 	if err != nil {
@@ -189,16 +192,33 @@ func (c *Client) LibraryPanelConnections(uid string) (*[]LibraryPanelConnection,
 }
 
 // LibraryPanelConnectedDashboards gets Dashboards using this Library Panel.
+// Connected dashboards which can't be found are skipped.
 func (c *Client) LibraryPanelConnectedDashboards(uid string) ([]FolderDashboardSearchResponse, error) {
 	connections, err := c.LibraryPanelConnections(uid)
 	if err != nil {
 		return nil, err
 	}
 
-	var dashboardIds []int64
+	// Searching without any dashboard filter would return all dashboards.
+	if len(*connections) == 0 {
+		return []FolderDashboardSearchResponse{}, nil
+	}
+
+	// Grafana matches dashboards against all filters, so only IDs, which connections always have, are used.
+	query := url.Values{"type": {"dash-db"}}
+	seen := make(map[int64]bool, len(*connections))
 	for _, connection := range *connections {
-		dashboardIds = append(dashboardIds, connection.DashboardID)
+		if seen[connection.DashboardID] {
+			continue
+		}
+		seen[connection.DashboardID] = true
+		query.Add("dashboardIds", fmt.Sprint(connection.DashboardID))
 	}
 
-	return c.DashboardsByIDs(dashboardIds)
+	return c.folderDashboardSearchAll(query)
+}
+
+// DashboardsUsingLibraryPanel is the same as LibraryPanelConnectedDashboards.
+func (c *Client) DashboardsUsingLibraryPanel(uid string) ([]FolderDashboardSearchResponse, error) {
+	return c.LibraryPanelConnectedDashboards(uid)
 }
@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -293,6 +297,44 @@ func TestLibraryPanelConnectedDashboards(t *testing.T) {
 	}
 }
 
+func TestDashboardsUsingLibraryPanel(t *testing.T) {
+	var query url.Values
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/search" {
+			query = r.URL.Query()
+			fmt.Fprint(w, getLibraryPanelConnectedDashboardsResponse)
+			return
+		}
+		fmt.Fprint(w, `{"result": [
+			{"id": 148, "kind": 1, "elementId": 25, "connectionId": 1, "connectionUid": "cIBgcSjkk"},
+			{"id": 149, "kind": 1, "elementId": 25, "connectionId": 2}
+		]}`)
+	}))
+
+	dashboards, err := client.DashboardsUsingLibraryPanel("V--OrYHnz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if len(dashboards) != 2 || dashboards[1].Title != "Production Overview 2" {
+		t.Errorf("Unexpected dashboards: %v", dashboards)
+	}
+	if _, ok := query["dashboardUIDs"]; ok || strings.Join(query["dashboardIds"], ",") != "1,2" {
+		t.Errorf("Unexpected search query: %v", query)
+	}
+
+	client = gapiTestTools(t, 200, `{"result": []}`)
+	dashboards, err = client.DashboardsUsingLibraryPanel("V--OrYHnz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dashboards) != 0 {
+		t.Errorf("Expected no dashboards for an unconnected library panel, got %v", dashboards)
+	}
+}
+
 func TestLibraryPanelDelete(t *testing.T) {
 	client := gapiTestTools(t, 200, deleteLibraryPanelResponse)
 
@@ -16,11 +16,6 @@ type mockServerCall struct {
 type mockServer struct {
 	upcomingCalls []mockServerCall
 	executedCalls []mockServerCall
-	server        *httptest.Server
-}
-
-func (m *mockServer) Close() {
-	m.server.Close()
 }
 
 func gapiTestTools(t *testing.T, code int, body string) *Client {
@@ -34,7 +29,7 @@ func gapiTestToolsFromCalls(t *testing.T, calls []mockServerCall) *Client {
 		upcomingCalls: calls,
 	}
 
-	mock.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if len(mock.upcomingCalls) == 0 {
 			t.Errorf("Missing handler for request: %s %s", r.Method, r.URL.Path)
 			return
@@ -49,11 +44,20 @@ func gapiTestToolsFromCalls(t *testing.T, calls []mockServerCall) *Client {
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprint(w, call.body)
 		mock.executedCalls = append(mock.executedCalls, call)
-	}))
+	})
+
+	return gapiTestToolsFromHandler(t, handler)
+}
+
+// gapiTestToolsFromHandler returns a client whose requests are all served by handler.
+func gapiTestToolsFromHandler(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
 
 	tr := &http.Transport{
 		Proxy: func(req *http.Request) (*url.URL, error) {
-			return url.Parse(mock.server.URL)
+			return url.Parse(server.URL)
 		},
 	}
 
@@ -65,7 +69,7 @@ func gapiTestToolsFromCalls(t *testing.T, calls []mockServerCall) *Client {
 	}
 
 	t.Cleanup(func() {
-		mock.Close()
+		server.Close()
 	})
 
 	return client
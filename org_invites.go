@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Invite represents a pending invite to join the current organization.
+type Invite struct {
+	ID             int64       `json:"id"`
+	OrgID          int64       `json:"orgId"`
+	Name           string      `json:"name"`
+	Email          string      `json:"email"`
+	Role           OrgRole     `json:"role"`
+	InvitedByLogin string      `json:"invitedByLogin"`
+	InvitedByEmail string      `json:"invitedByEmail"`
+	InvitedByName  string      `json:"invitedByName"`
+	Code           string      `json:"code"`
+	Status         string      `json:"status"`
+	URL            string      `json:"url"`
+	EmailSent      bool        `json:"emailSent"`
+	EmailSentOn    GrafanaTime `json:"emailSentOn"`
+	CreatedOn      GrafanaTime `json:"createdOn"`
+}
+
+// InviteRequest is used to invite a user to the current organization.
+type InviteRequest struct {
+	LoginOrEmail string  `json:"loginOrEmail"`
+	Name         string  `json:"name,omitempty"`
+	Role         OrgRole `json:"role"`
+	SendEmail    bool    `json:"sendEmail"`
+}
+
+// OrgInvites fetches and returns the pending invites of the current organization.
+func (c *Client) OrgInvites() ([]Invite, error) {
+	invites := make([]Invite, 0)
+	err := c.request("GET", "/api/org/invites", nil, nil, &invites)
+	if err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// CreateOrgInvite invites a user to the current organization with the given role.
+// The invite's code can be found with OrgInvites.
+func (c *Client) CreateOrgInvite(req InviteRequest) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	return c.request("POST", "/api/org/invites", nil, bytes.NewBuffer(data), nil)
+}
+
+// RevokeOrgInvite revokes the pending invite whose code it's passed.
+func (c *Client) RevokeOrgInvite(code string) error {
+	return c.request("DELETE", fmt.Sprintf("/api/org/invites/%s/revoke", url.PathEscape(code)), nil, nil, nil)
+}
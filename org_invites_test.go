@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const getOrgInvitesJSON = `[
+	{
+		"id": 3,
+		"orgId": 1,
+		"name": "Jane",
+		"email": "jane@example.com",
+		"role": "Editor",
+		"invitedByLogin": "admin",
+		"invitedByEmail": "admin@localhost",
+		"invitedByName": "admin",
+		"code": "lYmNUpUOCK",
+		"status": "InvitePending",
+		"url": "http://localhost:3000/invite/lYmNUpUOCK",
+		"emailSent": true,
+		"emailSentOn": "2023-06-01T12:00:00Z",
+		"createdOn": "2023-06-01T12:00:00Z"
+	}
+]`
+
+func TestOrgInvites(t *testing.T) {
+	client := gapiTestTools(t, 200, getOrgInvitesJSON)
+
+	invites, err := client.OrgInvites()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(invites))
+
+	if len(invites) != 1 || invites[0].Code != "lYmNUpUOCK" || invites[0].Role != RoleEditor {
+		t.Error("Not correctly parsing returned invites.")
+	}
+}
+
+func TestCreateOrgInvite(t *testing.T) {
+	var req InviteRequest
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/org/invites" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, `{"message": "Created invite for jane@example.com"}`)
+	}))
+
+	expected := InviteRequest{LoginOrEmail: "jane@example.com", Name: "Jane", Role: RoleEditor, SendEmail: true}
+	if err := client.CreateOrgInvite(expected); err != nil {
+		t.Fatal(err)
+	}
+	if req != expected {
+		t.Errorf("expected %v; got %v", expected, req)
+	}
+}
+
+func TestRevokeOrgInvite(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/api/org/invites/lYmNUpUOCK/revoke" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"message": "Invite revoked"}`)
+	}))
+
+	if err := client.RevokeOrgInvite("lYmNUpUOCK"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,6 +1,9 @@
 package gapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 )
 
@@ -54,3 +57,34 @@ func TestUpdateAllOrgPreference(t *testing.T) {
 		t.Errorf("Expected org preferences message '%s'; got '%s'", expected, resp.Message)
 	}
 }
+
+func TestOrgPreferences_extraRoundTrip(t *testing.T) {
+	var updated map[string]interface{}
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"theme": "dark", "navbar": {"savedItems": []}, "cookiePreferences": {"analytics": {}}, "language": "fr-FR"}`)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, updateOrgPreferencesJSON)
+	}))
+
+	prefs, err := client.OrgPreferences()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prefs.Extra) != 2 || prefs.Extra["language"] != "fr-FR" {
+		t.Errorf("Expected unmodeled preferences in Extra, got %v", prefs.Extra)
+	}
+
+	prefs.Theme = "light"
+	prefs.Extra["theme"] = "ignored"
+	if _, err := client.UpdateOrgPreferences(prefs); err != nil {
+		t.Fatal(err)
+	}
+	if updated["theme"] != "light" || updated["language"] != "fr-FR" || updated["cookiePreferences"] == nil {
+		t.Errorf("Expected unmodeled preferences to be written back, got %v", updated)
+	}
+}
@@ -4,12 +4,24 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // Org represents a Grafana org.
 type Org struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID      int64      `json:"id"`
+	Name    string     `json:"name"`
+	Address OrgAddress `json:"address"`
+}
+
+// OrgAddress represents the address of a Grafana org, as shown on generated reports.
+type OrgAddress struct {
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2"`
+	City     string `json:"city"`
+	ZipCode  string `json:"zipCode"`
+	State    string `json:"state"`
+	Country  string `json:"country"`
 }
 
 // Orgs fetches and returns the Grafana orgs.
@@ -26,7 +38,7 @@ func (c *Client) Orgs() ([]Org, error) {
 // OrgByName fetches and returns the org whose name it's passed.
 func (c *Client) OrgByName(name string) (Org, error) {
 	org := Org{}
-	err := c.request("GET", fmt.Sprintf("/api/orgs/name/%s", name), nil, nil, &org)
+	err := c.request("GET", fmt.Sprintf("/api/orgs/name/%s", url.PathEscape(name)), nil, nil, &org)
 	if err != nil {
 		return org, err
 	}
@@ -81,6 +93,16 @@ func (c *Client) UpdateOrg(id int64, name string) error {
 	return c.request("PUT", fmt.Sprintf("/api/orgs/%d", id), nil, bytes.NewBuffer(data), nil)
 }
 
+// UpdateOrgAddress updates the address of a Grafana org. Failures are returned as an APIError.
+func (c *Client) UpdateOrgAddress(id int64, addr OrgAddress) error {
+	data, err := json.Marshal(addr)
+	if err != nil {
+		return err
+	}
+
+	return c.request("PUT", fmt.Sprintf("/api/orgs/%d/address", id), nil, bytes.NewBuffer(data), nil)
+}
+
 // DeleteOrg deletes the Grafana org whose ID it's passed.
 func (c *Client) DeleteOrg(id int64) error {
 	return c.request("DELETE", fmt.Sprintf("/api/orgs/%d", id), nil, nil, nil)
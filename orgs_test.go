@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -88,6 +92,33 @@ func TestUpdateOrg(t *testing.T) {
 	}
 }
 
+func TestUpdateOrgAddress(t *testing.T) {
+	var addr OrgAddress
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/orgs/1/address" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&addr); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, `{"message":"Address updated"}`)
+	}))
+
+	expected := OrgAddress{Address1: "1 Main Street", City: "Stockholm", ZipCode: "111 22", Country: "Sweden"}
+	if err := client.UpdateOrgAddress(int64(1), expected); err != nil {
+		t.Fatal(err)
+	}
+	if addr != expected {
+		t.Errorf("expected %v; got %v", expected, addr)
+	}
+
+	client = gapiTestTools(t, 403, `{"message":"Permission denied"}`)
+	var apiErr APIError
+	if err := client.UpdateOrgAddress(int64(1), expected); !errors.As(err, &apiErr) || apiErr.StatusCode != 403 {
+		t.Errorf("expected an API error; got %v", err)
+	}
+}
+
 func TestDeleteOrg(t *testing.T) {
 	client := gapiTestTools(t, 200, deletedOrgJSON)
 
@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const pagedListPerPage = 1000
+
+// PagedList fetches all items from an endpoint responding with a count-based envelope such as
+// {"totalCount": 2, "page": 1, "perPage": 1000, "items": [...]}, following pages until all items are fetched.
+// The items field is named after the resource on some endpoints, e.g. "users" or "serviceAccounts";
+// if there's no "items" field, the envelope's only array field is used.
+// The total count reported by the server is returned along with the items.
+func PagedList[T any](c *Client, path string, query url.Values) (items []T, totalCount int, err error) {
+	params := make(url.Values)
+	for k, v := range query {
+		params[k] = v
+	}
+	if params.Get("perpage") == "" {
+		params.Set("perpage", strconv.Itoa(pagedListPerPage))
+	}
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		envelope := make(map[string]json.RawMessage)
+		if err := c.request("GET", path, params, nil, &envelope); err != nil {
+			return nil, 0, err
+		}
+
+		var pageItems []T
+		totalCount, pageItems, err = decodePagedEnvelope[T](envelope)
+		if err != nil {
+			return nil, 0, err
+		}
+		if items == nil {
+			items = make([]T, 0, totalCount)
+		}
+		items = append(items, pageItems...)
+
+		if len(items) >= totalCount || len(pageItems) == 0 {
+			return items, totalCount, nil
+		}
+	}
+}
+
+func decodePagedEnvelope[T any](envelope map[string]json.RawMessage) (int, []T, error) {
+	var totalCount int
+	if raw, ok := envelope["totalCount"]; ok {
+		if err := json.Unmarshal(raw, &totalCount); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	rawItems, ok := envelope["items"]
+	if !ok {
+		for k, raw := range envelope {
+			if len(raw) == 0 || raw[0] != '[' {
+				continue
+			}
+			if ok {
+				return 0, nil, fmt.Errorf("ambiguous paged response, found more than one list field: %s", k)
+			}
+			rawItems, ok = raw, true
+		}
+	}
+
+	var items []T
+	if ok {
+		if err := json.Unmarshal(rawItems, &items); err != nil {
+			return 0, nil, err
+		}
+	}
+	return totalCount, items, nil
+}
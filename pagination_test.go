@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+func TestPagedList(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"totalCount": 3, "page": 1, "perPage": 2, "serviceAccounts": [{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]}`},
+		{200, `{"totalCount": 3, "page": 2, "perPage": 2, "serviceAccounts": [{"id": 3, "name": "c"}]}`},
+	})
+
+	items, total, err := PagedList[ServiceAccountDTO](client, "/api/serviceaccounts/search", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(items))
+
+	if total != 3 || len(items) != 3 {
+		t.Fatalf("Expected 3 items, got %d of %d", len(items), total)
+	}
+	if items[2].ID != 3 || items[2].Name != "c" {
+		t.Error("Not correctly parsing returned items.")
+	}
+}
+
+func TestPagedList_items(t *testing.T) {
+	client := gapiTestTools(t, 200, `{"totalCount": 1, "page": 1, "perPage": 1000, "items": [{"id": 1}]}`)
+
+	items, total, err := PagedList[ServiceAccountDTO](client, "/foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(items) != 1 || items[0].ID != 1 {
+		t.Errorf("Not correctly parsing returned items: %v", items)
+	}
+
+	client = gapiTestTools(t, 500, "error")
+	if _, _, err = PagedList[ServiceAccountDTO](client, "/foo", nil); err == nil {
+		t.Error("500 not detected")
+	}
+}
@@ -0,0 +1,44 @@
+package gapi
+
+// PermissionLevel is the level of a dashboard or folder permission, granted to a user, team or role.
+type PermissionLevel int64
+
+// Permission levels, each including the permissions of the levels below it.
+const (
+	// PermissionView allows viewing the dashboard or folder, and the dashboards in the folder.
+	PermissionView PermissionLevel = 1
+	// PermissionEdit allows editing it as well, and creating and deleting dashboards in the folder.
+	PermissionEdit PermissionLevel = 2
+	// PermissionAdmin allows managing its permissions as well.
+	PermissionAdmin PermissionLevel = 4
+)
+
+// OrgRole is the role of a user within an organization. Permissions can be granted to all users with a role.
+type OrgRole string
+
+// Organization roles, from the least to the most privileged.
+const (
+	// RoleNone grants no permission on the organization's resources.
+	RoleNone OrgRole = "None"
+	// RoleViewer allows viewing dashboards, folders and other resources.
+	RoleViewer OrgRole = "Viewer"
+	// RoleEditor allows editing them as well.
+	RoleEditor OrgRole = "Editor"
+	// RoleAdmin allows managing the organization, its users and their permissions as well.
+	RoleAdmin OrgRole = "Admin"
+)
+
+// PermissionItems represents the permission items of a dashboard or folder permissions update.
+type PermissionItems struct {
+	Items []*PermissionItem `json:"items"`
+}
+
+// PermissionItem represents a dashboard or folder permission item.
+type PermissionItem struct {
+	// As you can see the docs, each item has a pair of [Role|TeamID|UserID] and Permission.
+	// unnecessary fields are omitted.
+	Role       OrgRole         `json:"role,omitempty"`
+	TeamID     int64           `json:"teamId,omitempty"`
+	UserID     int64           `json:"userId,omitempty"`
+	Permission PermissionLevel `json:"permission"`
+}
@@ -1,5 +1,11 @@
 package gapi
 
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
 // NavLink represents a Grafana nav link.
 type NavLink struct {
 	ID     string `json:"id,omitempty"`
@@ -28,4 +34,72 @@ type Preferences struct {
 	Locale           string                 `json:"locale,omitempty"`
 	Navbar           NavbarPreference       `json:"navbar,omitempty"`
 	QueryHistory     QueryHistoryPreference `json:"queryHistory,omitempty"`
+
+	// Extra holds the preferences this struct doesn't model, such as ones added by newer Grafana versions.
+	// They are written back along with the modeled preferences, so that reading, modifying and updating
+	// preferences doesn't drop them.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// preferences has the fields of Preferences without its JSON methods.
+type preferences Preferences
+
+// preferencesFields are the JSON names of the fields modeled by Preferences.
+var preferencesFields = jsonFieldNames(reflect.TypeOf(preferences{}))
+
+// MarshalJSON implements json.Marshaler, adding the Extra preferences to the modeled ones.
+func (p Preferences) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(preferences(p))
+	if err != nil || len(p.Extra) == 0 {
+		return data, err
+	}
+
+	fields := make(map[string]interface{}, len(p.Extra)+len(preferencesFields))
+	for k, v := range p.Extra {
+		fields[k] = v
+	}
+	// The modeled preferences take precedence over extra ones of the same name.
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the preferences which aren't modeled in Extra.
+func (p *Preferences) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*preferences)(p)); err != nil {
+		return err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	p.Extra = nil
+	for k, v := range fields {
+		if _, ok := preferencesFields[k]; ok {
+			continue
+		}
+		if p.Extra == nil {
+			p.Extra = make(map[string]interface{})
+		}
+		p.Extra[k] = v
+	}
+	return nil
+}
+
+// jsonFieldNames returns the JSON names of the fields of a struct type.
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, field := range reflect.VisibleFields(t) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		names[name] = struct{}{}
+	}
+	return names
 }
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Provisioning kinds which can be reloaded with ReloadProvisioning.
+const (
+	ProvisioningDashboards    = "dashboards"
+	ProvisioningDatasources   = "datasources"
+	ProvisioningPlugins       = "plugins"
+	ProvisioningAlerting      = "alerting"
+	ProvisioningAccessControl = "access-control"
+)
+
+// ProvisioningError is a provisioning file which failed to apply.
+type ProvisioningError struct {
+	// Path is the file's path, as reported by Grafana. It's empty if the failure couldn't be tied to a file.
+	Path    string
+	Message string
+}
+
+// ProvisioningReloadError is returned when reloading provisioning files fails, listing the failures.
+type ProvisioningReloadError struct {
+	Kind   string
+	Errors []ProvisioningError
+	// Err is the underlying API error.
+	Err error
+}
+
+func (e *ProvisioningReloadError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, pe := range e.Errors {
+		messages[i] = pe.Message
+	}
+	return fmt.Sprintf("failed to reload %s provisioning: %s", e.Kind, strings.Join(messages, "; "))
+}
+
+func (e *ProvisioningReloadError) Unwrap() error {
+	return e.Err
+}
+
+// provisioningFilePattern matches the paths of provisioning files in Grafana's error messages.
+var provisioningFilePattern = regexp.MustCompile(`[^\s:"']+\.(?:ya?ml|json)\b`)
+
+// ReloadProvisioning makes Grafana reload the provisioning files of the given kind, such as
+// ProvisioningDashboards. Grafana has no endpoint listing provisioning errors, so they are parsed from the
+// reload's error response: if it fails, a *ProvisioningReloadError listing the failures, one per line of
+// Grafana's error, is returned.
+func (c *Client) ReloadProvisioning(kind string) error {
+	err := c.request("POST", fmt.Sprintf("/api/admin/provisioning/%s/reload", kind), nil, nil, nil)
+
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode < 500 {
+		return err
+	}
+
+	reloadErr := &ProvisioningReloadError{Kind: kind, Err: err}
+	for _, line := range provisioningErrorLines(apiErr) {
+		reloadErr.Errors = append(reloadErr.Errors, ProvisioningError{
+			Path:    provisioningFilePattern.FindString(line),
+			Message: line,
+		})
+	}
+	return reloadErr
+}
+
+// provisioningErrorLines returns the lines of the message and error fields of a failed reload's response.
+// Grafana joins the errors of multiple files with newlines.
+func provisioningErrorLines(apiErr APIError) []string {
+	var lines []string
+	for _, key := range []string{"message", "error"} {
+		text, _ := apiErr.Body[key].(string)
+		for _, line := range strings.Split(text, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, apiErr.Message())
+	}
+	return lines
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+func TestReloadProvisioning(t *testing.T) {
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/admin/provisioning/dashboards/reload" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"message": "Dashboards config reloaded"}`)
+	}))
+
+	if err := client.ReloadProvisioning(ProvisioningDashboards); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadProvisioning_errors(t *testing.T) {
+	client := gapiTestTools(t, 500, `{
+		"message": "",
+		"error": "could not parse provisioning config file: /etc/grafana/provisioning/dashboards/team.yaml error: yaml: line 3: mapping values are not allowed in this context\nfailed to connect to database"
+	}`)
+
+	err := client.ReloadProvisioning(ProvisioningDashboards)
+
+	var reloadErr *ProvisioningReloadError
+	if !errors.As(err, &reloadErr) {
+		t.Fatalf("expected a provisioning reload error; got: %v", err)
+	}
+
+	t.Log(pretty.PrettyFormat(reloadErr.Errors))
+
+	if len(reloadErr.Errors) != 2 || reloadErr.Errors[0].Path != "/etc/grafana/provisioning/dashboards/team.yaml" || reloadErr.Errors[1].Path != "" {
+		t.Errorf("Not correctly parsing provisioning errors - %v", reloadErr.Errors)
+	}
+	if !errors.Is(err, ErrServerError) {
+		t.Errorf("expected the API error to be wrapped; got: %v", err)
+	}
+
+	client = gapiTestTools(t, 403, `{"message": "Permission denied"}`)
+	if err := client.ReloadProvisioning(ProvisioningDashboards); err == nil || errors.As(err, &reloadErr) {
+		t.Errorf("expected a plain API error; got: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// PublicDashboardListItem represents a public dashboard, as listed by ListPublicDashboards.
+type PublicDashboardListItem struct {
+	UID          string `json:"uid"`
+	AccessToken  string `json:"accessToken"`
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	DashboardUID string `json:"dashboardUid"`
+	IsEnabled    bool   `json:"isEnabled"`
+}
+
+// ListPublicDashboards fetches and returns all public dashboards of the current organization, with their access
+// tokens and whether they are enabled. It requires Grafana 9.1, which introduced public dashboards.
+func (c *Client) ListPublicDashboards() ([]PublicDashboardListItem, error) {
+	if err := c.requireVersion(9, 1, "public dashboards"); err != nil {
+		return nil, err
+	}
+
+	params := make(url.Values)
+	params.Set("perpage", strconv.Itoa(pagedListPerPage))
+
+	var items []PublicDashboardListItem
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		var raw json.RawMessage
+		if err := c.request("GET", "/api/dashboards/public-dashboards", params, nil, &raw); err != nil {
+			return nil, err
+		}
+
+		// Grafana versions before 10.2 respond with a plain list rather than a paged envelope.
+		if len(raw) > 0 && raw[0] == '[' {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return nil, err
+			}
+			return items, nil
+		}
+
+		envelope := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			return nil, err
+		}
+		totalCount, pageItems, err := decodePagedEnvelope[PublicDashboardListItem](envelope)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+
+		if len(items) >= totalCount || len(pageItems) == 0 {
+			if items == nil {
+				items = make([]PublicDashboardListItem, 0)
+			}
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const (
+	publicDashboardsPage1JSON = `{
+		"publicDashboards": [
+			{"uid": "e9f29a3c", "accessToken": "0b458cb7", "title": "Production Overview", "slug": "production-overview", "dashboardUid": "cIBgcSjkk", "isEnabled": true}
+		],
+		"totalCount": 2,
+		"page": 1,
+		"perPage": 1
+	}`
+	publicDashboardsPage2JSON = `{
+		"publicDashboards": [
+			{"uid": "a7d1c8f0", "accessToken": "9c2a4d11", "title": "Status", "slug": "status", "dashboardUid": "status", "isEnabled": false}
+		],
+		"totalCount": 2,
+		"page": 2,
+		"perPage": 1
+	}`
+)
+
+func TestListPublicDashboards(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, publicDashboardsPage1JSON},
+		{200, publicDashboardsPage2JSON},
+	})
+
+	dashboards, err := client.ListPublicDashboards()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(dashboards))
+
+	if len(dashboards) != 2 {
+		t.Fatalf("Expected 2 public dashboards, got %d", len(dashboards))
+	}
+	if dashboards[0].DashboardUID != "cIBgcSjkk" || dashboards[0].AccessToken != "0b458cb7" || !dashboards[0].IsEnabled {
+		t.Errorf("Not correctly parsing returned public dashboard - %v", dashboards[0])
+	}
+	if dashboards[1].Title != "Status" || dashboards[1].IsEnabled {
+		t.Errorf("Not correctly parsing returned public dashboard - %v", dashboards[1])
+	}
+}
+
+func TestListPublicDashboards_plainList(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"database": "ok", "version": "9.5.2"}`},
+		{200, frontendSettingsJSON},
+		{200, `[{"uid": "e9f29a3c", "accessToken": "0b458cb7", "title": "Production Overview", "dashboardUid": "cIBgcSjkk", "isEnabled": true}]`},
+	})
+
+	dashboards, err := client.ListPublicDashboards()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dashboards) != 1 || dashboards[0].AccessToken != "0b458cb7" {
+		t.Errorf("Not correctly parsing returned public dashboards - %v", dashboards)
+	}
+}
+
+func TestListPublicDashboards_unsupportedVersion(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `{"database": "ok", "version": "9.0.9"}`},
+		{200, frontendSettingsJSON},
+	})
+
+	if _, err := client.ListPublicDashboards(); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Expected unsupported version error, got %v", err)
+	}
+}
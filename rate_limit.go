@@ -0,0 +1,103 @@
+package gapi
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitInfo is the rate limit budget reported by the X-RateLimit-* headers of a response,
+// as returned by Grafana Cloud.
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed per window, and Remaining the number left in the current one.
+	Limit     int
+	Remaining int
+	// Reset is when the current window ends. It's zero when the response didn't say.
+	Reset time.Time
+}
+
+// RateLimit returns the rate limit budget reported by the latest response carrying X-RateLimit-* headers,
+// so callers can slow down before being rate limited. It returns false if no response carried them.
+// The budget is shared by the client and the clients derived from it.
+func (c *Client) RateLimit() (RateLimitInfo, bool) {
+	if c.rateLimit == nil {
+		return RateLimitInfo{}, false
+	}
+
+	c.rateLimit.mu.Lock()
+	defer c.rateLimit.mu.Unlock()
+	if c.rateLimit.info == nil {
+		return RateLimitInfo{}, false
+	}
+	return *c.rateLimit.info, true
+}
+
+// waitForRateLimit waits until the end of the rate limit window if the latest response reported no requests
+// remaining in it, unless the client's context is done or its timeout is reached first.
+func (c *Client) waitForRateLimit() error {
+	info, ok := c.RateLimit()
+	if !ok || info.Remaining > 0 || info.Reset.IsZero() {
+		return nil
+	}
+	wait := time.Until(info.Reset)
+	if wait <= 0 {
+		return nil
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTracker keeps the latest rate limit budget reported to a client.
+type rateLimitTracker struct {
+	mu   sync.Mutex
+	info *RateLimitInfo
+}
+
+func (t *rateLimitTracker) record(header http.Header) {
+	if t == nil {
+		return
+	}
+	info, ok := parseRateLimitInfo(header, time.Now())
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.info = &info
+}
+
+// parseRateLimitInfo parses the X-RateLimit-* headers. X-RateLimit-Reset is either a Unix timestamp or,
+// for small values, a number of seconds from now.
+func parseRateLimitInfo(header http.Header, now time.Time) (RateLimitInfo, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimitInfo{}, false
+	}
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return RateLimitInfo{}, false
+	}
+
+	info := RateLimitInfo{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		// Timestamps before 2001 are taken to be relative.
+		if reset < 1e9 {
+			info.Reset = now.Add(time.Duration(reset) * time.Second)
+		} else {
+			info.Reset = time.Unix(reset, 0)
+		}
+	}
+	return info, true
+}
@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	remaining := 100
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining--
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+		w.Header().Set("X-RateLimit-Reset", "1685620800")
+		fmt.Fprint(w, `{}`)
+	}))
+
+	if _, ok := client.RateLimit(); ok {
+		t.Error("expected no rate limit before the first request")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := client.request("GET", "/foo", nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	info, ok := client.WithOrgID(2).RateLimit()
+	if !ok {
+		t.Fatal("expected a rate limit")
+	}
+	if info.Limit != 100 || info.Remaining != 98 || !info.Reset.Equal(time.Unix(1685620800, 0)) {
+		t.Errorf("Not correctly parsing rate limit headers - %v", info)
+	}
+}
+
+func TestParseRateLimitInfo(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	header := make(http.Header)
+	if _, ok := parseRateLimitInfo(header, now); ok {
+		t.Error("expected no rate limit without headers")
+	}
+
+	header.Set("X-RateLimit-Limit", "50")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "30")
+	info, ok := parseRateLimitInfo(header, now)
+	if !ok || info.Remaining != 0 || !info.Reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("Not correctly parsing relative reset - %v", info)
+	}
+}
+
+func TestWaitForRateLimit(t *testing.T) {
+	client := gapiTestTools(t, 200, `{}`)
+
+	start := time.Now()
+	if err := client.waitForRateLimit(); err != nil || time.Since(start) > 10*time.Millisecond {
+		t.Errorf("Expected no wait without a rate limit, got %v after %s", err, time.Since(start))
+	}
+
+	client.rateLimit.info = &RateLimitInfo{Limit: 100, Remaining: 0, Reset: time.Now().Add(50 * time.Millisecond)}
+	start = time.Now()
+	if err := client.waitForRateLimit(); err != nil || time.Since(start) < 40*time.Millisecond {
+		t.Errorf("Expected to wait for the rate limit reset, got %v after %s", err, time.Since(start))
+	}
+
+	client.rateLimit.info = &RateLimitInfo{Limit: 100, Remaining: 0, Reset: time.Now().Add(time.Second)}
+	start = time.Now()
+	if err := client.WithTimeout(20 * time.Millisecond).waitForRateLimit(); !errors.Is(err, context.DeadlineExceeded) || time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Expected the wait to be bounded by the timeout, got %v after %s", err, time.Since(start))
+	}
+}
@@ -0,0 +1,127 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// ReportBranding represents the branding applied to the PDFs and emails of Grafana reports.
+type ReportBranding struct {
+	// ReportLogoURL is the logo shown on report PDFs, and EmailLogoURL the logo shown in report emails.
+	ReportLogoURL string `json:"reportLogoUrl"`
+	EmailLogoURL  string `json:"emailLogoUrl"`
+	// EmailFooterMode is either sent-by, the default footer, or none.
+	EmailFooterMode string `json:"emailFooterMode"`
+	EmailFooterText string `json:"emailFooterText"`
+	EmailFooterLink string `json:"emailFooterLink"`
+}
+
+// ReportSettings represents the organization wide settings of Grafana reports.
+type ReportSettings struct {
+	ID       int64          `json:"id,omitempty"`
+	OrgID    int64          `json:"orgId,omitempty"`
+	UserID   int64          `json:"userId,omitempty"`
+	Branding ReportBranding `json:"branding"`
+	// EmbeddedImageTheme is the theme, light or dark, of the dashboard image embedded in report emails,
+	// and PDFTheme the theme of the report PDFs.
+	EmbeddedImageTheme string `json:"embeddedImageTheme,omitempty"`
+	PDFTheme           string `json:"pdfTheme,omitempty"`
+}
+
+// ReportLogo identifies a logo of the report branding.
+type ReportLogo string
+
+const (
+	// ReportLogoPDF is the logo shown on report PDFs.
+	ReportLogoPDF ReportLogo = "reportLogo"
+	// ReportLogoEmail is the logo shown in report emails.
+	ReportLogoEmail ReportLogo = "emailLogo"
+)
+
+// GetReportSettings fetches and returns the report settings of the current organization.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) GetReportSettings() (*ReportSettings, error) {
+	if err := c.requireEnterprise("report settings"); err != nil {
+		return nil, err
+	}
+
+	settings := &ReportSettings{}
+	if err := c.request("GET", "/api/reports/settings", nil, nil, settings); err != nil {
+		return nil, reportSettingsError(err)
+	}
+
+	return settings, nil
+}
+
+// UpdateReportSettings replaces the report settings of the current organization.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) UpdateReportSettings(s ReportSettings) error {
+	if err := c.requireEnterprise("report settings"); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return reportSettingsError(c.request("POST", "/api/reports/settings", nil, bytes.NewBuffer(data), nil))
+}
+
+// UploadReportLogo uploads an image file as a logo of the report branding, keeping the other report settings.
+// Grafana stores the image and sets the matching logo URL of the settings' Branding to it.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) UploadReportLogo(logo ReportLogo, filename string, image io.Reader) error {
+	settings, err := c.GetReportSettings()
+	if err != nil {
+		return err
+	}
+
+	// Logos are uploaded the way the reporting settings page does, as a multipart form holding the
+	// settings in its config field alongside the image files.
+	config, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	if err := form.WriteField("config", string(config)); err != nil {
+		return err
+	}
+	part, err := form.CreateFormFile(string(logo), filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, image); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	client := c.WithHeaders(map[string]string{"Content-Type": form.FormDataContentType()})
+	return reportSettingsError(client.request("POST", "/api/reports/settings", nil, body, nil))
+}
+
+// reportSettingsError explains the errors report settings requests fail with when reporting isn't available
+// or allowed, keeping the API error wrapped.
+func reportSettingsError(err error) error {
+	var apiErr APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("report settings not found, reporting may be disabled or unlicensed: %w", err)
+	case http.StatusForbidden:
+		return fmt.Errorf("not allowed to access report settings, the reports.settings permissions are required: %w", err)
+	}
+	return err
+}
@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const getReportSettingsJSON = `
+{
+	"id": 1,
+	"orgId": 1,
+	"userId": 1,
+	"branding": {
+		"reportLogoUrl": "https://example.com/logo.png",
+		"emailLogoUrl": "",
+		"emailFooterMode": "sent-by",
+		"emailFooterText": "Acme Corp",
+		"emailFooterLink": "https://example.com"
+	},
+	"embeddedImageTheme": "dark",
+	"pdfTheme": "light"
+}
+`
+
+func TestGetReportSettings(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, getReportSettingsJSON},
+	})
+
+	settings, err := client.GetReportSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(settings))
+
+	if settings.Branding.ReportLogoURL != "https://example.com/logo.png" || settings.Branding.EmailFooterText != "Acme Corp" || settings.PDFTheme != "light" {
+		t.Error("Not correctly parsing returned report settings.")
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{403, `{"message": "Access denied"}`},
+	})
+	_, err = client.GetReportSettings()
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden || !strings.Contains(err.Error(), "permissions") {
+		t.Errorf("Expected forbidden error, got %v", err)
+	}
+
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, `{"buildInfo": {"version": "10.0.0", "edition": "Open Source"}}`},
+	})
+	if _, err := client.GetReportSettings(); !errors.Is(err, ErrEnterpriseRequired) {
+		t.Errorf("Expected enterprise required error, got %v", err)
+	}
+}
+
+func TestUpdateReportSettings(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, `{"message": "Report settings saved"}`},
+	})
+
+	err := client.UpdateReportSettings(ReportSettings{
+		Branding: ReportBranding{EmailFooterMode: "none"},
+		PDFTheme: "dark",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadReportLogo(t *testing.T) {
+	var (
+		config   ReportSettings
+		filename string
+		image    []byte
+	)
+	calls := 0
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch {
+		case r.URL.Path == "/api/health":
+			fmt.Fprint(w, healthOKJSON)
+		case r.URL.Path == "/api/frontend/settings":
+			fmt.Fprint(w, frontendSettingsJSON)
+		case r.Method == http.MethodGet:
+			fmt.Fprint(w, getReportSettingsJSON)
+		default:
+			if err := json.Unmarshal([]byte(r.FormValue("config")), &config); err != nil {
+				t.Error(err)
+			}
+			file, header, err := r.FormFile("emailLogo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			filename = header.Filename
+			image, _ = io.ReadAll(file)
+			fmt.Fprint(w, `{"message": "Report settings saved"}`)
+		}
+	}))
+
+	if err := client.UploadReportLogo(ReportLogoEmail, "logo.png", strings.NewReader("png")); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 4 {
+		t.Errorf("Expected 4 requests, got %d", calls)
+	}
+	if filename != "logo.png" || string(image) != "png" {
+		t.Errorf("Invalid uploaded logo %q: %q", filename, image)
+	}
+	if config.Branding.EmailFooterText != "Acme Corp" {
+		t.Errorf("Expected current settings to be kept, got %v", config)
+	}
+}
@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultResolutionCacheTTL is the default time entries of the resolution cache are kept.
+const DefaultResolutionCacheTTL = 5 * time.Minute
+
+// resolutionCache caches name to ID/UID resolutions per organization.
+// A nil cache is valid and caches nothing.
+type resolutionCache[V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[resolutionCacheKey]resolutionCacheEntry[V]
+}
+
+type resolutionCacheKey struct {
+	orgID int64
+	name  string
+}
+
+type resolutionCacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func newResolutionCache[V any](ttl time.Duration) *resolutionCache[V] {
+	if ttl <= 0 {
+		ttl = DefaultResolutionCacheTTL
+	}
+	return &resolutionCache[V]{
+		ttl:     ttl,
+		entries: make(map[resolutionCacheKey]resolutionCacheEntry[V]),
+	}
+}
+
+func (c *resolutionCache[V]) get(orgID int64, name string) (V, bool) {
+	var zero V
+	if c == nil {
+		return zero, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := resolutionCacheKey{orgID, name}
+	entry, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *resolutionCache[V]) set(orgID int64, name string, value V) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[resolutionCacheKey{orgID, name}] = resolutionCacheEntry[V]{
+		value:   value,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+// empty returns a new empty cache with the same TTL, or nil if the cache is nil.
+func (c *resolutionCache[V]) empty() *resolutionCache[V] {
+	if c == nil {
+		return nil
+	}
+	return newResolutionCache[V](c.ttl)
+}
+
+// invalidate drops all entries, as creating, renaming or deleting an entity can change any resolution.
+func (c *resolutionCache[V]) invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[resolutionCacheKey]resolutionCacheEntry[V])
+}
@@ -0,0 +1,114 @@
+package gapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+const searchFoldersByTitleJSON = `[
+	{"id": 1, "uid": "payments", "title": "Payments", "type": "dash-folder"},
+	{"id": 2, "uid": "payments-eu", "title": "Payments EU", "type": "dash-folder"}
+]`
+
+func TestFolderUIDByTitle_cache(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, searchFoldersByTitleJSON},
+		{200, createdFolderJSON},
+		{200, searchFoldersByTitleJSON},
+	})
+	client.folderUIDs = newResolutionCache[string](0)
+
+	// The second lookup is served from the cache, the third one follows a folder creation.
+	for i := 0; i < 2; i++ {
+		uid, err := client.FolderUIDByTitle("Payments")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uid != "payments" {
+			t.Errorf("Invalid uid - %s, Expected %s", uid, "payments")
+		}
+	}
+
+	if _, err := client.NewFolder("Departmenet ABC"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.FolderUIDByTitle("Payments"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFolderUIDByTitle_notFound(t *testing.T) {
+	client := gapiTestTools(t, 200, searchFoldersByTitleJSON)
+
+	if _, err := client.FolderUIDByTitle("Pay"); err == nil {
+		t.Error("Expected not found error")
+	}
+}
+
+func TestTeamIDByName_cache(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, searchTeamJSON},
+		{200, deleteTeamJSON},
+		{200, searchTeamJSON},
+	})
+	client.teamIDs = newResolutionCache[int64](0)
+
+	for i := 0; i < 2; i++ {
+		id, err := client.TeamIDByName("MyTestTeam")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != 1 {
+			t.Errorf("Invalid id - %d, Expected %d", id, 1)
+		}
+	}
+
+	if err := client.DeleteTeam(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.TeamIDByName("MyTestTeam"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFolderUIDByTitle_cachePerCredentials(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, searchFoldersByTitleJSON},
+		{200, `[{"id": 3, "uid": "other-payments", "title": "Payments", "type": "dash-folder"}]`},
+	})
+	client.folderUIDs = newResolutionCache[string](0)
+
+	// Both clients have no org ID, but their tokens may belong to different orgs.
+	for i, expected := range []string{"payments", "other-payments"} {
+		uid, err := client.WithAPIKey(fmt.Sprintf("org-%d-token", i+1)).FolderUIDByTitle("Payments")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uid != expected {
+			t.Errorf("Invalid uid - %s, Expected %s", uid, expected)
+		}
+	}
+}
+
+func TestResolutionCache_expiry(t *testing.T) {
+	cache := newResolutionCache[string](time.Millisecond)
+	cache.set(1, "Payments", "payments")
+	if uid, ok := cache.get(1, "Payments"); !ok || uid != "payments" {
+		t.Errorf("Expected cached value, got %s", uid)
+	}
+	if _, ok := cache.get(2, "Payments"); ok {
+		t.Error("Expected entries to be scoped to their org")
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	if _, ok := cache.get(1, "Payments"); ok {
+		t.Error("Expected entry to expire")
+	}
+
+	var disabled *resolutionCache[string]
+	disabled.set(1, "Payments", "payments")
+	if _, ok := disabled.get(1, "Payments"); ok {
+		t.Error("Expected nil cache to cache nothing")
+	}
+}
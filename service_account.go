@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -41,6 +42,9 @@ type ServiceAccountDTO struct {
 	AvatarURL  string `json:"avatarUrl"`
 }
 
+// ServiceAccount is a Grafana service account, as returned by SearchServiceAccounts.
+type ServiceAccount = ServiceAccountDTO
+
 type RetrieveServiceAccountResponse struct {
 	TotalCount      int64               `json:"totalCount"`
 	ServiceAccounts []ServiceAccountDTO `json:"serviceAccounts"`
@@ -66,6 +70,10 @@ type GetServiceAccountTokensResponse struct {
 	HasExpired             bool       `json:"hasExpired,omitempty"`
 }
 
+// ServiceAccountToken is a Grafana service account token, as returned by ListServiceAccountTokens.
+// Its secret is only returned when creating it.
+type ServiceAccountToken = GetServiceAccountTokensResponse
+
 // DeleteServiceAccountResponse represents the response from deleting a service account
 // or a service account token.
 type DeleteServiceAccountResponse struct {
@@ -136,6 +144,24 @@ func (c *Client) GetServiceAccountTokens(serviceAccountID int64) ([]GetServiceAc
 	return response, err
 }
 
+// SearchServiceAccounts returns the service accounts of the organization whose name or login match the
+// query, following the result pages. An empty query matches all service accounts.
+func (c *Client) SearchServiceAccounts(query string) ([]ServiceAccount, error) {
+	params := make(url.Values)
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	serviceAccounts, _, err := PagedList[ServiceAccount](c, "/api/serviceaccounts/search", params)
+	return serviceAccounts, err
+}
+
+// ListServiceAccountTokens returns the tokens of the service account with the specified ID, along with their
+// expiration.
+func (c *Client) ListServiceAccountTokens(saID int64) ([]ServiceAccountToken, error) {
+	return c.GetServiceAccountTokens(saID)
+}
+
 // DeleteServiceAccount deletes the Grafana service account with the specified ID.
 func (c *Client) DeleteServiceAccount(serviceAccountID int64) (*DeleteServiceAccountResponse, error) {
 	response := DeleteServiceAccountResponse{}
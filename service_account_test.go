@@ -172,3 +172,36 @@ func TestGetServiceAccountTokens(t *testing.T) {
 
 	t.Log(pretty.PrettyFormat(res))
 }
+
+func TestSearchServiceAccounts(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{http.StatusOK, `{"totalCount": 3, "serviceAccounts": [{"id": 8, "name": "deploy"}, {"id": 9, "name": "deploy-ci"}], "page": 1, "perPage": 2}`},
+		{http.StatusOK, `{"totalCount": 3, "serviceAccounts": [{"id": 10, "name": "deploy-cd"}], "page": 2, "perPage": 2}`},
+	})
+
+	res, err := client.SearchServiceAccounts("deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(res))
+
+	if len(res) != 3 || res[2].ID != 10 {
+		t.Errorf("Expected all pages of service accounts, got %v", res)
+	}
+}
+
+func TestListServiceAccountTokens(t *testing.T) {
+	client := gapiTestTools(t, http.StatusOK, getServiceAccountTokensJSON)
+
+	res, err := client.ListServiceAccountTokens(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(res))
+
+	if len(res) != 3 || res[0].Expiration == nil || !res[1].HasExpired || res[2].Expiration != nil {
+		t.Error("Not correctly parsing returned service account tokens.")
+	}
+}
@@ -3,6 +3,9 @@ package gapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 )
 
 // Snapshot represents a Grafana snapshot.
@@ -20,6 +23,20 @@ type SnapshotCreateResponse struct {
 	ID        int64  `json:"id"`
 }
 
+// DashboardSnapshot represents a Grafana snapshot as listed by the API.
+type DashboardSnapshot struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Key         string    `json:"key"`
+	OrgID       int64     `json:"orgId"`
+	UserID      int64     `json:"userId"`
+	External    bool      `json:"external"`
+	ExternalURL string    `json:"externalUrl"`
+	Expires     time.Time `json:"expires"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
 // NewSnapshot creates a new Grafana snapshot.
 func (c *Client) NewSnapshot(snapshot Snapshot) (*SnapshotCreateResponse, error) {
 	data, err := json.Marshal(snapshot)
@@ -35,3 +52,47 @@ func (c *Client) NewSnapshot(snapshot Snapshot) (*SnapshotCreateResponse, error)
 
 	return result, err
 }
+
+// Snapshots fetches and returns all snapshots of the current organization.
+func (c *Client) Snapshots() ([]DashboardSnapshot, error) {
+	snapshots := make([]DashboardSnapshot, 0)
+	err := c.request("GET", "/api/dashboard/snapshots", nil, nil, &snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// DeleteSnapshot deletes the Grafana snapshot whose key it's passed.
+func (c *Client) DeleteSnapshot(key string) error {
+	return c.request("DELETE", fmt.Sprintf("/api/snapshots/%s", key), nil, nil, nil)
+}
+
+// DeleteExpiredSnapshots deletes all snapshots which expire before the given time and returns the number deleted.
+// Snapshots without an expiry time are kept.
+// It continues past snapshots which fail to be deleted, returning their errors along with the count.
+func (c *Client) DeleteExpiredSnapshots(before time.Time) (int, error) {
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		deleted int
+		errs    []error
+	)
+	for _, snapshot := range snapshots {
+		// Snapshots which never expire have no expiry time.
+		if snapshot.Expires.IsZero() || !snapshot.Expires.Before(before) {
+			continue
+		}
+		if err := c.DeleteSnapshot(snapshot.Key); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete snapshot %s: %w", snapshot.Key, err))
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, errors.Join(errs...)
+}
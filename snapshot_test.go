@@ -1,7 +1,9 @@
 package gapi
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gobs/pretty"
 )
@@ -45,3 +47,103 @@ func TestSnapshotCreate(t *testing.T) {
 		}
 	}
 }
+
+const getSnapshotsResponse = `[
+	{
+		"id": 8,
+		"name": "Expired",
+		"key": "expiredKey",
+		"orgId": 1,
+		"userId": 1,
+		"external": false,
+		"externalUrl": "",
+		"expires": "2020-01-01T00:00:00Z",
+		"created": "2019-12-31T00:00:00Z",
+		"updated": "2019-12-31T00:00:00Z"
+	},
+	{
+		"id": 9,
+		"name": "Also expired",
+		"key": "alsoExpiredKey",
+		"orgId": 1,
+		"userId": 1,
+		"expires": "2021-01-01T00:00:00Z",
+		"created": "2020-12-31T00:00:00Z",
+		"updated": "2020-12-31T00:00:00Z"
+	},
+	{
+		"id": 10,
+		"name": "Never expires",
+		"key": "neverExpiresKey",
+		"orgId": 1,
+		"userId": 1,
+		"expires": "2200-01-01T00:00:00Z",
+		"created": "2020-12-31T00:00:00Z",
+		"updated": "2020-12-31T00:00:00Z"
+	},
+	{
+		"id": 11,
+		"name": "No expiry",
+		"key": "noExpiryKey",
+		"orgId": 1,
+		"userId": 1,
+		"expires": null,
+		"created": "2020-12-31T00:00:00Z",
+		"updated": "2020-12-31T00:00:00Z"
+	},
+	{
+		"id": 12,
+		"name": "Missing expiry",
+		"key": "missingExpiryKey",
+		"orgId": 1,
+		"userId": 1,
+		"created": "2020-12-31T00:00:00Z",
+		"updated": "2020-12-31T00:00:00Z"
+	}
+]`
+
+func TestSnapshots(t *testing.T) {
+	client := gapiTestTools(t, 200, getSnapshotsResponse)
+
+	snapshots, err := client.Snapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(snapshots))
+
+	if len(snapshots) != 5 || snapshots[0].Key != "expiredKey" || snapshots[0].Expires.Year() != 2020 {
+		t.Error("Not correctly parsing returned snapshots.")
+	}
+}
+
+func TestDeleteExpiredSnapshots(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, getSnapshotsResponse},
+		{500, `{"message":"Failed to delete dashboard snapshot"}`},
+		{200, `{"message":"Snapshot deleted"}`},
+	})
+
+	deleted, err := client.DeleteExpiredSnapshots(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted snapshot, got %d", deleted)
+	}
+	if err == nil || !strings.Contains(err.Error(), "expiredKey") {
+		t.Errorf("Expected error for failed delete, got %v", err)
+	}
+
+	// Snapshots without an expiry time never expire, however late the given time is.
+	client = gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, getSnapshotsResponse},
+		{200, `{"message":"Snapshot deleted"}`},
+		{200, `{"message":"Snapshot deleted"}`},
+		{200, `{"message":"Snapshot deleted"}`},
+	})
+	deleted, err = client.DeleteExpiredSnapshots(time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 3 {
+		t.Errorf("Expected 3 deleted snapshots, got %d", deleted)
+	}
+}
@@ -3,6 +3,7 @@ package gapi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 )
@@ -94,6 +95,7 @@ func (c *Client) AddTeam(name string, email string) (int64, error) {
 	if err != nil {
 		return id, err
 	}
+	c.teamIDs.invalidate()
 
 	return tmp.ID, err
 }
@@ -113,12 +115,44 @@ func (c *Client) UpdateTeam(id int64, name string, email string) error {
 		return err
 	}
 
-	return c.request("PUT", path, nil, bytes.NewBuffer(data), nil)
+	err = c.request("PUT", path, nil, bytes.NewBuffer(data), nil)
+	if err != nil {
+		return err
+	}
+	c.teamIDs.invalidate()
+
+	return nil
 }
 
 // DeleteTeam deletes the Grafana team whose ID it's passed.
 func (c *Client) DeleteTeam(id int64) error {
-	return c.request("DELETE", fmt.Sprintf("/api/teams/%d", id), nil, nil, nil)
+	err := c.request("DELETE", fmt.Sprintf("/api/teams/%d", id), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	c.teamIDs.invalidate()
+
+	return nil
+}
+
+// TeamIDByName returns the ID of the Grafana team with the given name.
+func (c *Client) TeamIDByName(name string) (int64, error) {
+	if id, ok := c.teamIDs.get(c.config.OrgID, name); ok {
+		return id, nil
+	}
+
+	search, err := c.SearchTeam(name)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, team := range search.Teams {
+		if team.Name == name {
+			c.teamIDs.set(c.config.OrgID, name, team.ID)
+			return team.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("team with name %s not found", name)
 }
 
 // TeamMembers fetches and returns the team members for the Grafana team whose ID it's passed.
@@ -151,6 +185,67 @@ func (c *Client) RemoveMemberFromTeam(id int64, userID int64) error {
 	return c.request("DELETE", path, nil, nil, nil)
 }
 
+// teamAdminPermission is the TeamMember.Permission of team admins.
+const teamAdminPermission = 4
+
+// SyncTeamMembers adds and removes members of the Grafana team whose ID it's passed, so that its members are
+// the users whose IDs it's passed. The last remaining team admin is never removed, so that the team stays manageable.
+// It continues past members which fail to be added or removed, returning the IDs of the users actually added and
+// removed along with the errors.
+func (c *Client) SyncTeamMembers(teamID int64, desiredUserIDs []int64) (added, removed []int64, err error) {
+	members, err := c.TeamMembers(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	desired := make(map[int64]bool, len(desiredUserIDs))
+	for _, userID := range desiredUserIDs {
+		desired[userID] = true
+	}
+
+	current := make(map[int64]bool, len(members))
+	admins := 0
+	for _, member := range members {
+		current[member.UserID] = true
+		if member.Permission == teamAdminPermission {
+			admins++
+		}
+	}
+
+	var errs []error
+	for _, userID := range desiredUserIDs {
+		if current[userID] {
+			continue
+		}
+		// Guard against duplicate IDs in desiredUserIDs.
+		current[userID] = true
+		if err := c.AddTeamMember(teamID, userID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add user %d to team %d: %w", userID, teamID, err))
+			continue
+		}
+		added = append(added, userID)
+	}
+
+	for _, member := range members {
+		if desired[member.UserID] {
+			continue
+		}
+		if member.Permission == teamAdminPermission && admins == 1 {
+			continue
+		}
+		if err := c.RemoveMemberFromTeam(teamID, member.UserID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove user %d from team %d: %w", member.UserID, teamID, err))
+			continue
+		}
+		if member.Permission == teamAdminPermission {
+			admins--
+		}
+		removed = append(removed, member.UserID)
+	}
+
+	return added, removed, errors.Join(errs...)
+}
+
 // TeamPreferences fetches and returns preferences for the Grafana team whose ID it's passed.
 func (c *Client) TeamPreferences(id int64) (*Preferences, error) {
 	preferences := &Preferences{}
@@ -172,3 +267,17 @@ func (c *Client) UpdateTeamPreferences(id int64, preferences Preferences) error
 
 	return c.request("PUT", path, nil, bytes.NewBuffer(data), nil)
 }
+
+// SetTeamHomeDashboard sets the home dashboard of the Grafana team whose ID it's passed
+// to the dashboard whose UID it's passed, keeping the team's other preferences.
+func (c *Client) SetTeamHomeDashboard(teamID int64, uid string) error {
+	preferences, err := c.TeamPreferences(teamID)
+	if err != nil {
+		return err
+	}
+
+	preferences.HomeDashboardID = 0
+	preferences.HomeDashboardUID = uid
+
+	return c.UpdateTeamPreferences(teamID, *preferences)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // TeamGroup represents a Grafana TeamGroup.
@@ -39,5 +40,5 @@ func (c *Client) NewTeamGroup(id int64, groupID string) error {
 
 // DeleteTeam deletes the Grafana team whose ID it's passed.
 func (c *Client) DeleteTeamGroup(id int64, groupID string) error {
-	return c.request("DELETE", fmt.Sprintf("/api/teams/%d/groups/%s", id, groupID), nil, nil, nil)
+	return c.request("DELETE", fmt.Sprintf("/api/teams/%d/groups/%s", id, url.PathEscape(groupID)), nil, nil, nil)
 }
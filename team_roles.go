@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetTeamRoles fetches and returns the RBAC roles assigned to the team whose UID it's passed.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) GetTeamRoles(teamUID string) ([]Role, error) {
+	if err := c.requireEnterprise("team role assignments"); err != nil {
+		return nil, err
+	}
+
+	roles := make([]Role, 0)
+	err := c.request("GET", teamRolesURL(teamUID), nil, nil, &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// SetTeamRoles assigns the RBAC roles whose UIDs it's passed to the team whose UID it's passed.
+// With replace, the team's other roles are unassigned, otherwise the roles are added to them.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) SetTeamRoles(teamUID string, roleUIDs []string, replace bool) error {
+	if err := c.requireEnterprise("team role assignments"); err != nil {
+		return err
+	}
+
+	if replace {
+		data, err := json.Marshal(map[string]interface{}{"roleUids": roleUIDs})
+		if err != nil {
+			return err
+		}
+		return c.request("PUT", teamRolesURL(teamUID), nil, bytes.NewBuffer(data), nil)
+	}
+
+	for _, roleUID := range roleUIDs {
+		data, err := json.Marshal(map[string]string{"roleUid": roleUID})
+		if err != nil {
+			return err
+		}
+		if err := c.request("POST", teamRolesURL(teamUID), nil, bytes.NewBuffer(data), nil); err != nil {
+			return fmt.Errorf("failed to assign role %s to team %s: %w", roleUID, teamUID, err)
+		}
+	}
+	return nil
+}
+
+func teamRolesURL(teamUID string) string {
+	return fmt.Sprintf("/api/access-control/teams/%s/roles", url.PathEscape(teamUID))
+}
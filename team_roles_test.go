@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobs/pretty"
+)
+
+const getTeamRolesJSON = `[
+	{"version": 1, "uid": "fixed_dashboards_writer", "name": "fixed:dashboards:writer", "displayName": "Dashboard writer", "global": true},
+	{"version": 2, "uid": "custom_reporter", "name": "custom:reports:reader", "global": false}
+]`
+
+func TestGetTeamRoles(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, getTeamRolesJSON},
+	})
+
+	roles, err := client.GetTeamRoles("ops")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(roles))
+
+	if len(roles) != 2 || roles[0].UID != "fixed_dashboards_writer" || !roles[0].Global {
+		t.Errorf("Not correctly parsing returned roles - %v", roles)
+	}
+}
+
+func TestSetTeamRoles(t *testing.T) {
+	var requests []string
+	client := gapiTestToolsFromHandler(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/health":
+			fmt.Fprint(w, healthOKJSON)
+			return
+		case "/api/frontend/settings":
+			fmt.Fprint(w, frontendSettingsJSON)
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		requests = append(requests, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path, body))
+		fmt.Fprint(w, `{"message": "Role added to the team."}`)
+	}))
+
+	if err := client.SetTeamRoles("ops", []string{"a", "b"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetTeamRoles("ops", []string{"a", "b"}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /api/access-control/teams/ops/roles map[roleUid:a]",
+		"POST /api/access-control/teams/ops/roles map[roleUid:b]",
+		"PUT /api/access-control/teams/ops/roles map[roleUids:[a b]]",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected requests %v; got %v", expected, requests)
+	}
+}
+
+func TestTeamRoles_OSS(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, `{"buildInfo": {"version": "10.0.0", "edition": "Open Source"}}`},
+	})
+
+	if _, err := client.GetTeamRoles("ops"); !errors.Is(err, ErrEnterpriseRequired) {
+		t.Errorf("Expected Enterprise required error, got %v", err)
+	}
+}
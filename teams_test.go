@@ -250,6 +250,27 @@ func TestRemoveMemberFromTeam(t *testing.T) {
 	}
 }
 
+func TestSyncTeamMembers(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, `[{"teamId": 1, "userId": 3, "permission": 0}, {"teamId": 1, "userId": 2, "permission": 4}]`},
+		{200, `{"message":"Member added to Team"}`},
+		{400, `{"message":"User is already added to this team"}`},
+		{200, removeMemberFromTeamJSON},
+	})
+
+	added, removed, err := client.SyncTeamMembers(1, []int64{5, 6, 5})
+	if err == nil {
+		t.Error("400 not detected")
+	}
+	if len(added) != 1 || added[0] != 5 {
+		t.Errorf("Expected user 5 to be added, got %v", added)
+	}
+	// User 2 is the last team admin and is kept.
+	if len(removed) != 1 || removed[0] != 3 {
+		t.Errorf("Expected user 3 to be removed, got %v", removed)
+	}
+}
+
 func TestTeamPreferences(t *testing.T) {
 	client := gapiTestTools(t, 200, getTeamPreferencesJSON)
 
@@ -286,3 +307,19 @@ func TestUpdateTeamPreferences(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSetTeamHomeDashboard(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, getTeamPreferencesJSON},
+		{200, updateTeamPreferencesJSON},
+	})
+
+	if err := client.SetTeamHomeDashboard(1, "cIBgcSjkk"); err != nil {
+		t.Error(err)
+	}
+
+	client = gapiTestTools(t, 404, `{"message":"Team not found"}`)
+	if err := client.SetTeamHomeDashboard(1, "cIBgcSjkk"); err == nil {
+		t.Error("404 not detected")
+	}
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import "fmt"
+
+// UsageInsights describes how much a dashboard is used, as tracked by Grafana Enterprise.
+type UsageInsights struct {
+	DashboardUID string      `json:"dashboardUid"`
+	ViewCount    int64       `json:"viewCount"`
+	QueryCount   int64       `json:"queryCount"`
+	Errors       int64       `json:"errors"`
+	LastViewed   GrafanaTime `json:"lastViewed"`
+}
+
+// DashboardUsageInsights fetches and returns the usage insights of the dashboard whose UID it's passed.
+// It requires Grafana Enterprise, an error wrapping ErrEnterpriseRequired is returned on other editions.
+func (c *Client) DashboardUsageInsights(uid string) (*UsageInsights, error) {
+	if err := c.requireEnterprise("usage insights"); err != nil {
+		return nil, err
+	}
+
+	insights := &UsageInsights{}
+	err := c.request("GET", fmt.Sprintf("/api/usage/dashboard/%s", uid), nil, nil, insights)
+	if err != nil {
+		return nil, err
+	}
+
+	return insights, nil
+}
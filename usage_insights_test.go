@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobs/pretty"
+)
+
+const getUsageInsightsJSON = `{
+	"dashboardUid": "cIBgcSjkk",
+	"viewCount": 42,
+	"queryCount": 1337,
+	"errors": 3,
+	"lastViewed": "2023-06-12T15:04:05Z"
+}`
+
+func TestDashboardUsageInsights(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, getUsageInsightsJSON},
+	})
+
+	insights, err := client.DashboardUsageInsights("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(insights))
+
+	if insights.ViewCount != 42 || insights.QueryCount != 1337 || insights.Errors != 3 || insights.LastViewed.Time().Day() != 12 {
+		t.Errorf("Not correctly parsing returned usage insights: %v", insights)
+	}
+}
+
+func TestDashboardUsageInsights_epochMillis(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, frontendSettingsJSON},
+		{200, `{"dashboardUid": "cIBgcSjkk", "lastViewed": 1686582245000}`},
+	})
+
+	insights, err := client.DashboardUsageInsights("cIBgcSjkk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !insights.LastViewed.Time().Equal(time.Date(2023, 6, 12, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("Not correctly parsing returned last view time: %v", insights.LastViewed.Time())
+	}
+}
+
+func TestDashboardUsageInsights_OSS(t *testing.T) {
+	client := gapiTestToolsFromCalls(t, []mockServerCall{
+		{200, healthOKJSON},
+		{200, strings.Replace(frontendSettingsJSON, "Enterprise", "Open Source", 1)},
+	})
+
+	_, err := client.DashboardUsageInsights("cIBgcSjkk")
+	if !errors.Is(err, ErrEnterpriseRequired) {
+		t.Errorf("Expected enterprise required error, got %v", err)
+	}
+}
@@ -44,6 +44,13 @@ type UserSearch struct {
 	AvatarURL     string    `json:"avatarUrl,omitempty"`
 }
 
+// UserOrg represents an organization the current user is a member of.
+type UserOrg struct {
+	OrgID int64   `json:"orgId"`
+	Name  string  `json:"name"`
+	Role  OrgRole `json:"role"`
+}
+
 // Users fetches and returns Grafana users.
 func (c *Client) Users() (users []UserSearch, err error) {
 	var (
@@ -85,3 +92,31 @@ func (c *Client) UserUpdate(u User) error {
 	}
 	return c.request("PUT", fmt.Sprintf("/api/users/%d", u.ID), nil, bytes.NewBuffer(data), nil)
 }
+
+// CurrentUser fetches the authenticated user, which is the service account of a service account token.
+func (c *Client) CurrentUser() (user User, err error) {
+	err = c.request("GET", "/api/user", nil, nil, &user)
+	return
+}
+
+// CurrentUserOrgs fetches and returns the organizations the authenticated user is a member of.
+func (c *Client) CurrentUserOrgs() ([]UserOrg, error) {
+	orgs := make([]UserOrg, 0)
+	err := c.request("GET", "/api/user/orgs", nil, nil, &orgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// CurrentUserTeams fetches and returns the teams the authenticated user is a member of.
+func (c *Client) CurrentUserTeams() ([]Team, error) {
+	teams := make([]Team, 0)
+	err := c.request("GET", "/api/user/teams", nil, nil, &teams)
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
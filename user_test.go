@@ -11,6 +11,8 @@ const (
 	getUserJSON        = `{"id":2,"email":"user@localhost","isGrafanaAdmin":false}`
 	getUserByEmailJSON = `{"id":3,"email":"userByEmail@localhost","isGrafanaAdmin":true}`
 	getUserUpdateJSON  = `{"id":4,"email":"userUpdate@localhost","isGrafanaAdmin":false}`
+	getUserOrgsJSON    = `[{"orgId":1,"name":"Main Org.","role":"Admin"},{"orgId":2,"name":"Other Org.","role":"Viewer"}]`
+	getUserTeamsJSON   = `[{"id":1,"orgId":1,"name":"MyTestTeam","email":"","avatarUrl":"\/avatar\/3f49c15916554246daa714b9bd0ee398","memberCount":1}]`
 )
 
 func TestUsers(t *testing.T) {
@@ -89,3 +91,40 @@ func TestUserUpdate(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestCurrentUserOrgs(t *testing.T) {
+	client := gapiTestTools(t, 200, getUserOrgsJSON)
+
+	orgs, err := client.CurrentUserOrgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(orgs))
+
+	if len(orgs) != 2 || orgs[1].OrgID != 2 || orgs[1].Name != "Other Org." || orgs[1].Role != "Viewer" {
+		t.Error("Not correctly parsing returned user orgs.")
+	}
+
+	for _, code := range []int{401, 403, 500} {
+		client = gapiTestTools(t, code, "error")
+		if _, err = client.CurrentUserOrgs(); err == nil {
+			t.Errorf("%d not detected", code)
+		}
+	}
+}
+
+func TestCurrentUserTeams(t *testing.T) {
+	client := gapiTestTools(t, 200, getUserTeamsJSON)
+
+	teams, err := client.CurrentUserTeams()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(pretty.PrettyFormat(teams))
+
+	if len(teams) != 1 || teams[0].Name != "MyTestTeam" || teams[0].MemberCount != 1 {
+		t.Error("Not correctly parsing returned user teams.")
+	}
+}